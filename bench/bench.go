@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package bench benchmarks pseudo-random generators across a standard mix
+// of operations, so that they can be compared on the same hardware.
+//
+// For example:
+//
+//	func BenchmarkFastrand(b *testing.B) { bench.Global(b) }
+//	func BenchmarkPCG(b *testing.B)      { bench.Source(b, rand.NewPCG(1, 2)) }
+//	func BenchmarkChaCha8(b *testing.B)  { bench.Source(b, rand.NewChaCha8([32]byte{})) }
+package bench
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"testing"
+
+	"bursavich.dev/fastrand"
+)
+
+// boundedN is a bound that isn't a power of two, so that bounded draws
+// occasionally reject values.
+const boundedN = 1_000_003
+
+// fillSize is the size of the buffer filled by the Fill benchmarks.
+const fillSize = 4096
+
+// Global runs sub-benchmarks of the global fastrand functions.
+func Global(b *testing.B) {
+	run(b, ops{
+		uint64:  fastrand.Uint64,
+		uint64n: fastrand.Uint64n,
+		float64: fastrand.Float64,
+		fill:    fastrand.Fill,
+	})
+}
+
+// Source runs sub-benchmarks of a math/rand/v2 generator backed by src.
+// Fill is implemented by writing successive values of src.
+// A src that isn't safe for concurrent use must not be shared.
+func Source(b *testing.B, src rand.Source) {
+	r := rand.New(src)
+	run(b, ops{
+		uint64:  r.Uint64,
+		uint64n: r.Uint64N,
+		float64: r.Float64,
+		fill: func(p []byte) {
+			for len(p) >= 8 {
+				binary.LittleEndian.PutUint64(p, src.Uint64())
+				p = p[8:]
+			}
+			if len(p) > 0 {
+				v := src.Uint64()
+				for i := range p {
+					p[i] = byte(v >> (i * 8))
+				}
+			}
+		},
+	})
+}
+
+type ops struct {
+	uint64  func() uint64
+	uint64n func(uint64) uint64
+	float64 func() float64
+	fill    func([]byte)
+}
+
+var (
+	sinkUint64  uint64
+	sinkFloat64 float64
+)
+
+func run(b *testing.B, o ops) {
+	b.Run("Uint64", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkUint64 = o.uint64()
+		}
+	})
+	b.Run("Uint64n", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkUint64 = o.uint64n(boundedN)
+		}
+	})
+	b.Run("Float64", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkFloat64 = o.float64()
+		}
+	})
+	b.Run("Fill", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(fillSize)
+		buf := make([]byte, fillSize)
+		for i := 0; i < b.N; i++ {
+			o.fill(buf)
+		}
+	})
+}
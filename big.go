@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// BigIntn returns a pseudo-random integer in the half-open interval [0,n).
+// It panics if n <= 0.
+func BigIntn(n *big.Int) *big.Int {
+	if n.Sign() <= 0 {
+		panic("fastrand.BigIntn: invalid argument")
+	}
+	// Draw integers with as many bits as n-1 until one is less than n,
+	// which takes fewer than two tries on average.
+	max := new(big.Int).Sub(n, big.NewInt(1))
+	bitLen := max.BitLen()
+	if bitLen == 0 {
+		return new(big.Int)
+	}
+	words := make([]big.Word, (bitLen+bits.UintSize-1)/bits.UintSize)
+	mask := big.Word(1)<<(uint(bitLen-1)%bits.UintSize+1) - 1
+	v := new(big.Int)
+	for {
+		for i := range words {
+			words[i] = big.Word(u64())
+		}
+		words[len(words)-1] &= mask
+		if v.SetBits(words).Cmp(max) <= 0 {
+			return v
+		}
+	}
+}
+
+// BigFloat returns a pseudo-random float with precision prec in the half-open
+// interval [0,1). It's a uniformly chosen multiple of 2^-prec, all of which
+// are exactly representable.
+// It panics if prec is zero or greater than big.MaxPrec.
+func BigFloat(prec uint) *big.Float {
+	if prec == 0 || prec > big.MaxPrec {
+		panic("fastrand.BigFloat: invalid argument")
+	}
+	words := make([]big.Word, (prec+bits.UintSize-1)/bits.UintSize)
+	for i := range words {
+		words[i] = big.Word(u64())
+	}
+	if r := prec % bits.UintSize; r != 0 {
+		words[len(words)-1] &= big.Word(1)<<r - 1
+	}
+	mant := new(big.Float).SetPrec(prec).SetInt(new(big.Int).SetBits(words))
+	return mant.SetMantExp(mant, -int(prec))
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// Allocate returns how many of n items fall into each bucket when every item
+// independently picks a bucket with probability proportional to its weight.
+// The counts are drawn from the exact multinomial distribution in time
+// proportional to the number of buckets rather than the number of items.
+// It panics if n < 0, if any weight is negative, infinite, or NaN,
+// or if the weights don't have a positive finite sum.
+func Allocate(n int, weights []float64) []int {
+	if n < 0 {
+		panic("fastrand.Allocate: invalid argument")
+	}
+	total := sumWeights(weights, "fastrand.Allocate")
+	last := len(weights) - 1
+	for weights[last] == 0 {
+		last--
+	}
+	// Conditional binomial method: the count of each bucket is binomial
+	// given the items and weight that remain after the preceding buckets.
+	counts := make([]int, len(weights))
+	for i, w := range weights[:last] {
+		if n == 0 {
+			return counts
+		}
+		if w == 0 {
+			continue
+		}
+		k := int(binomial(int64(n), min(w/total, 1)))
+		counts[i] = k
+		n -= k
+		total -= w
+	}
+	counts[last] = n
+	return counts
+}
+
+// binomial returns the number of successes in n independent trials
+// that each succeed with probability p.
+func binomial(n int64, p float64) int64 {
+	switch {
+	case n == 0 || p <= 0:
+		return 0
+	case p >= 1:
+		return n
+	case p > 0.5:
+		return n - binomial(n, 1-p)
+	case float64(n)*p < 10:
+		return binomialInversion(n, p)
+	default:
+		return binomialBTRS(n, p)
+	}
+}
+
+// binomialInversion counts geometrically distributed waiting times between
+// successes. It takes expected time proportional to n*p.
+func binomialInversion(n int64, p float64) int64 {
+	logq := math.Log1p(-p)
+	var k, sum int64
+	for {
+		// The gap may exceed the range of int64 when p is tiny,
+		// so compare it with the remaining trials before converting.
+		gap := math.Ceil(math.Log(unitOpenZero()) / logq)
+		if gap > float64(n-sum) {
+			return k
+		}
+		sum += int64(gap)
+		k++
+	}
+}
+
+// binomialBTRS is the transformed rejection with squeeze algorithm.
+// It requires p <= 0.5 and n*p >= 10.
+//
+// See "The generation of binomial random variates" (Hörmann, 1993).
+func binomialBTRS(n int64, p float64) int64 {
+	count := float64(n)
+	stddev := math.Sqrt(count * p * (1 - p))
+	b := 1.15 + 2.53*stddev
+	a := -0.0873 + 0.0248*b + 0.01*p
+	c := count*p + 0.5
+	vr := 0.92 - 4.2/b
+	r := p / (1 - p)
+	alpha := (2.83 + 5.1/b) * stddev
+	m := math.Floor((count + 1) * p)
+	for {
+		u := Float64() - 0.5
+		v := Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + c)
+		if k < 0 || k > count {
+			continue
+		}
+		if us >= 0.07 && v <= vr {
+			return int64(k)
+		}
+		v = math.Log(v * alpha / (a/(us*us) + b))
+		bound := (m+0.5)*math.Log((m+1)/(r*(count-m+1))) +
+			(count+1)*math.Log((count-m+1)/(count-k+1)) +
+			(k+0.5)*math.Log(r*(count-k+1)/(k+1)) +
+			stirlingTail(m) + stirlingTail(count-m) -
+			stirlingTail(k) - stirlingTail(count-k)
+		if v <= bound {
+			return int64(k)
+		}
+	}
+}
+
+var stirlingTails = [10]float64{
+	0.0810614667953272, 0.0413406959554092, 0.0276779256849983,
+	0.02079067210376509, 0.0166446911898211, 0.0138761288230707,
+	0.0118967099458917, 0.0104112652619720, 0.00925546218271273,
+	0.00833056343336287,
+}
+
+// stirlingTail returns log(k!) - (log(sqrt(2*pi)) + (k+0.5)*log(k+1) - (k+1)),
+// the error of Stirling's approximation.
+func stirlingTail(k float64) float64 {
+	if k <= 9 {
+		return stirlingTails[int(k)]
+	}
+	kp1sq := (k + 1) * (k + 1)
+	return (1.0/12 - (1.0/360-1.0/1260/kp1sq)/kp1sq) / (k + 1)
+}
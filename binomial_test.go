@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "testing"
+
+func TestAllocateTinyWeight(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		counts := Allocate(5, []float64{1e-300, 1})
+		if counts[0] != 0 || counts[1] != 5 {
+			t.Fatalf("Allocate(5, [1e-300 1]) = %v; want [0 5]", counts)
+		}
+	}
+}
+
+func TestAllocateSum(t *testing.T) {
+	weights := []float64{1e-300, 0, 0.5, 3, 1e-12, 2}
+	for _, n := range []int{0, 1, 7, 1000, 1 << 20} {
+		counts := Allocate(n, weights)
+		sum := 0
+		for i, k := range counts {
+			if k < 0 || (weights[i] == 0 && k != 0) {
+				t.Fatalf("Allocate(%d, %v) = %v; invalid count", n, weights, counts)
+			}
+			sum += k
+		}
+		if sum != n {
+			t.Fatalf("Allocate(%d, %v) = %v; sum %d", n, weights, counts, sum)
+		}
+	}
+}
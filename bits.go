@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// FillBits sets each bit of p to 1 independently with probability prob,
+// or to 0 otherwise. Its cost is proportional to the number of bits in the
+// minority, so sparse and dense masks are cheap to generate.
+// It panics if prob is not in the closed interval [0,1].
+func FillBits(p []byte, prob float64) {
+	if !(prob >= 0 && prob <= 1) {
+		panic("fastrand.FillBits: invalid probability")
+	}
+	if prob == 0.5 {
+		Fill(p)
+		return
+	}
+	// Draw the minority value at sparse positions over a background of the other.
+	var bg byte
+	if prob > 0.5 {
+		bg, prob = 0xff, 1-prob
+	}
+	for i := range p {
+		p[i] = bg
+	}
+	bernoulliTrials(len(p)*8, prob, func(i int) {
+		p[i/8] ^= 1 << (i % 8)
+	})
+}
+
+// FillBools sets each element of dst to true independently with probability p,
+// or to false otherwise, such as to build a sampling mask. Its cost beyond
+// clearing dst is proportional to the number of elements in the minority.
+// It panics if p is not in the closed interval [0,1].
+func FillBools(dst []bool, p float64) {
+	if !(p >= 0 && p <= 1) {
+		panic("fastrand.FillBools: invalid probability")
+	}
+	// Draw the minority value at sparse positions over a background of the other.
+	bg := p > 0.5
+	if bg {
+		p = 1 - p
+	}
+	for i := range dst {
+		dst[i] = bg
+	}
+	bernoulliTrials(len(dst), p, func(i int) {
+		dst[i] = !bg
+	})
+}
+
+// bernoulliTrials calls success with the index of each success, in increasing
+// order, among n independent trials that succeed with probability prob. It skips
+// between successes with geometrically distributed gaps, so its cost is
+// proportional to the number of successes rather than trials.
+func bernoulliTrials(n int, prob float64, success func(i int)) {
+	if prob <= 0 {
+		return
+	}
+	if prob >= 1 {
+		for i := 0; i < n; i++ {
+			success(i)
+		}
+		return
+	}
+	logq := math.Log1p(-prob)
+	for i := -1; ; {
+		gap := math.Floor(math.Log(unitOpenZero()) / logq)
+		if gap >= float64(n-1-i) {
+			return
+		}
+		i += int(gap) + 1
+		success(i)
+	}
+}
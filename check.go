@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "errors"
+
+// Check verifies that the generator works: that it returns varying values
+// and that Fill writes every byte of its buffer. The global functions are
+// linked to internals of the go runtime, so a program may call Check at
+// startup to fail loudly if a new version of Go breaks them, rather than
+// silently produce degenerate values.
+//
+// A working generator fails with negligible probability.
+func Check() error {
+	const n = 8
+	var v32 [n]uint32
+	var v64 [n]uint64
+	for i := 0; i < n; i++ {
+		v32[i], v64[i] = u32(), u64()
+	}
+	if allEqual(v32[:]) {
+		return errors.New("fastrand: 32-bit generator returns constant values")
+	}
+	if allEqual(v64[:]) {
+		return errors.New("fastrand: 64-bit generator returns constant values")
+	}
+	// Each byte of a buffer should change from both 0x00 and 0xff, at
+	// least once in a few fills. A buffer of odd length checks the tail.
+	var zeros, ones [4*8 + 5]byte
+	var changed [len(zeros)]bool
+	for i := 0; i < n; i++ {
+		clear(zeros[:])
+		for j := range ones {
+			ones[j] = 0xff
+		}
+		Fill(zeros[:])
+		Fill(ones[:])
+		for j := range changed {
+			changed[j] = changed[j] || (zeros[j] != 0x00 && ones[j] != 0xff)
+		}
+	}
+	for _, ok := range changed {
+		if !ok {
+			return errors.New("fastrand: Fill doesn't write its buffer")
+		}
+	}
+	return nil
+}
+
+func allEqual[T comparable](s []T) bool {
+	for _, v := range s[1:] {
+		if v != s[0] {
+			return false
+		}
+	}
+	return true
+}
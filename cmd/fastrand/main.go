@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Command fastrand writes pseudo-random bytes to standard output,
+// such as to feed statistical test suites:
+//
+//	fastrand | RNG_test stdin64
+//	fastrand | dieharder -a -g 200
+//
+// Usage:
+//
+//	fastrand [-n bytes]
+//
+// By default, it writes until standard output is closed.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+
+	"bursavich.dev/fastrand"
+)
+
+func main() {
+	n := flag.Int64("n", -1, "number of bytes to write, or unlimited if negative")
+	flag.Parse()
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	w := bufio.NewWriterSize(os.Stdout, 1<<20)
+	_, err := fastrand.WriteTo(w, *n)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "fastrand:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"image/color"
+	"math"
+)
+
+// ColorRGB returns an opaque pseudo-random color.
+func ColorRGB() color.RGBA {
+	v := u32()
+	return color.RGBA{R: uint8(v), G: uint8(v >> 8), B: uint8(v >> 16), A: 0xff}
+}
+
+// ColorHex returns a pseudo-random color in hexadecimal notation, e.g. "#1a2b3c".
+func ColorHex() string {
+	b := make([]byte, 1, 7)
+	b[0] = '#'
+	return bytesToString(appendHex(b, 6))
+}
+
+// ColorHSL returns an opaque color with a pseudo-random hue and the given
+// saturation and lightness, such as to generate a palette of distinct colors
+// that are equally readable.
+// It panics if saturation or lightness is not in the closed interval [0,1].
+func ColorHSL(saturation, lightness float64) color.RGBA {
+	if !(saturation >= 0 && saturation <= 1) || !(lightness >= 0 && lightness <= 1) {
+		panic("fastrand.ColorHSL: invalid argument")
+	}
+	// https://en.wikipedia.org/wiki/HSL_and_HSV#HSL_to_RGB_alternative
+	h := Float64() * 12
+	a := saturation * min(lightness, 1-lightness)
+	f := func(n float64) uint8 {
+		k := math.Mod(n+h, 12)
+		v := lightness - a*max(-1, min(k-3, 9-k, 1))
+		return uint8(math.Round(v * 255))
+	}
+	return color.RGBA{R: f(0), G: f(8), B: f(4), A: 0xff}
+}
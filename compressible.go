@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"io"
+	"math"
+)
+
+// compressibleChunk is the size of the blocks in which compressible data is generated.
+// It's small enough to fit within the window of any common compression algorithm.
+const compressibleChunk = 512
+
+// FillCompressible fills p with pseudo-random bytes of which approximately
+// the given fraction is compressible. For example, with a compressibility of
+// 0.5 common compression algorithms will reduce p to roughly half its size.
+//
+// Each 512-byte block is filled with pseudo-random bytes followed by a run
+// of a single repeated byte whose length is determined by compressibility.
+// It panics if compressibility is not in the closed interval [0,1].
+func FillCompressible(p []byte, compressibility float64) {
+	if !(compressibility >= 0 && compressibility <= 1) {
+		panic("fastrand.FillCompressible: invalid compressibility")
+	}
+	fillCompressible(p, compressibility)
+}
+
+func fillCompressible(p []byte, compressibility float64) {
+	n := int(math.Round((1 - compressibility) * compressibleChunk))
+	for len(p) > 0 {
+		chunk := p[:min(len(p), compressibleChunk)]
+		p = p[len(chunk):]
+		r := min(n, len(chunk))
+		Fill(chunk[:r])
+		run := chunk[r:]
+		if len(run) == 0 {
+			continue
+		}
+		c := byte(u32())
+		for i := range run {
+			run[i] = c
+		}
+	}
+}
+
+// CompressibleReader returns an io.Reader that fills the read buffer with
+// pseudo-random bytes of which approximately the given fraction is compressible,
+// as described by FillCompressible, and never returns an error.
+// It panics if compressibility is not in the closed interval [0,1].
+func CompressibleReader(compressibility float64) io.Reader {
+	if !(compressibility >= 0 && compressibility <= 1) {
+		panic("fastrand.CompressibleReader: invalid compressibility")
+	}
+	return &compressibleReader{compressibility}
+}
+
+type compressibleReader struct {
+	compressibility float64
+}
+
+func (r *compressibleReader) Read(p []byte) (int, error) {
+	fillCompressible(p, r.compressibility)
+	return len(p), nil
+}
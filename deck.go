@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+// A Deck is a shuffled deck of cards that are drawn without replacement.
+// When it runs out, all of its cards are collected and reshuffled.
+type Deck[E any] struct {
+	all   []E
+	cards []E // remaining cards; the top of the deck is the end
+}
+
+// NewDeck returns a shuffled deck of the given cards.
+// It panics if there are no cards.
+func NewDeck[E any](cards ...E) *Deck[E] {
+	if len(cards) == 0 {
+		panic("fastrand.NewDeck: no cards")
+	}
+	d := &Deck[E]{
+		all:   append([]E(nil), cards...),
+		cards: make([]E, 0, len(cards)),
+	}
+	d.Reset()
+	return d
+}
+
+// Len returns the number of cards remaining in the deck.
+func (d *Deck[E]) Len() int {
+	return len(d.cards)
+}
+
+// Size returns the total number of cards in the deck.
+func (d *Deck[E]) Size() int {
+	return len(d.all)
+}
+
+// Reset collects all of the cards and shuffles them.
+func (d *Deck[E]) Reset() {
+	d.cards = append(d.cards[:0], d.all...)
+	Shuffle(d.cards)
+}
+
+// Shuffle shuffles the remaining cards.
+func (d *Deck[E]) Shuffle() {
+	Shuffle(d.cards)
+}
+
+// Draw removes and returns the top card of the deck.
+// If the deck is empty, it's reset first.
+func (d *Deck[E]) Draw() E {
+	if len(d.cards) == 0 {
+		d.Reset()
+	}
+	n := len(d.cards) - 1
+	c := d.cards[n]
+	var zero E
+	d.cards[n] = zero
+	d.cards = d.cards[:n]
+	return c
+}
+
+// DealHands deals n hands of k cards each, one card at a time to each hand
+// in turn. If fewer than n*k cards remain, the deck is reset first.
+// It panics if n < 0, k < 0, or n*k exceeds the size of the deck.
+func (d *Deck[E]) DealHands(n, k int) [][]E {
+	if n < 0 || k < 0 || (n > 0 && k > len(d.all)/n) {
+		panic("fastrand.Deck.DealHands: invalid argument")
+	}
+	if n*k > len(d.cards) {
+		d.Reset()
+	}
+	hands := make([][]E, n)
+	for i := range hands {
+		hands[i] = make([]E, 0, k)
+	}
+	for j := 0; j < k; j++ {
+		for i := range hands {
+			hands[i] = append(hands[i], d.Draw())
+		}
+	}
+	return hands
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package dice rolls pseudo-random dice.
+package dice
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"bursavich.dev/fastrand"
+)
+
+// MaxDice is the maximum number of dice in a term of dice notation.
+const MaxDice = 1000
+
+// Roll returns the sum of n rolls of a die with the given number of sides,
+// numbered from 1.
+// It panics if n < 0 or sides < 1.
+func Roll(n, sides int) int {
+	if n < 0 || sides < 1 {
+		panic("dice.Roll: invalid argument")
+	}
+	sum := n
+	for i := 0; i < n; i++ {
+		sum += int(fastrand.Uint64n(uint64(sides)))
+	}
+	return sum
+}
+
+// RollNotation returns the result of rolling dice described by dice notation:
+// a sum or difference of terms, each of which is either a constant or dice
+// written NdS, for N dice (one if omitted) with S sides. A d% die has 100 sides.
+// For example, "3d6+2", "d20", and "2d8-1d4+1". Case and spaces are ignored.
+// Each term may have at most MaxDice dice.
+func RollNotation(s string) (int, error) {
+	s = strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	if s == "" {
+		return 0, errors.New("dice: empty notation")
+	}
+	var sum int
+	for s != "" {
+		sign := 1
+		switch s[0] {
+		case '-':
+			sign = -1
+			fallthrough
+		case '+':
+			s = s[1:]
+		}
+		end := strings.IndexAny(s, "+-")
+		if end < 0 {
+			end = len(s)
+		}
+		v, err := rollTerm(s[:end])
+		if err != nil {
+			return 0, err
+		}
+		sum += sign * v
+		s = s[end:]
+	}
+	return sum, nil
+}
+
+func rollTerm(term string) (int, error) {
+	count, sides, ok := strings.Cut(term, "d")
+	if !ok {
+		return parseInt(term)
+	}
+	n := 1
+	if count != "" {
+		var err error
+		if n, err = parseInt(count); err != nil {
+			return 0, err
+		}
+		if n > MaxDice {
+			return 0, errors.New("dice: too many dice: " + term)
+		}
+	}
+	if sides == "%" {
+		return Roll(n, 100), nil
+	}
+	m, err := parseInt(sides)
+	if err != nil {
+		return 0, err
+	}
+	if m < 1 {
+		return 0, errors.New("dice: invalid number of sides: " + term)
+	}
+	return Roll(n, m), nil
+}
+
+func parseInt(s string) (int, error) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, errors.New("dice: invalid notation: " + strconv.Quote(s))
+	}
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, errors.New("dice: invalid number: " + strconv.Quote(s))
+	}
+	return int(v), nil
+}
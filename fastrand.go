@@ -6,10 +6,24 @@
 
 // Package fastrand provides quickly generated pseudo-random numbers
 // with no repeatability guarantees on the stream of values.
+//
+// Building with the deterministic tag replaces the generator with a seeded
+// one, such as to reproduce a flaky test:
+//
+//	FASTRAND_SEED=42 go test -tags deterministic -race ./...
+//
+// The seed is read from the FASTRAND_SEED environment variable as a signed
+// or unsigned 64-bit integer, or is zero if it's unset. Values are
+// reproducible only if they're drawn in the same order, such as by a single
+// goroutine. The seeded generator is protected by a mutex, so it's much
+// slower and scales poorly to many cores.
 package fastrand
 
 import (
 	"io"
+	"iter"
+	"math/bits"
+	"sort"
 
 	"golang.org/x/exp/constraints"
 )
@@ -89,7 +103,8 @@ func Uint32() uint32 {
 }
 
 // Uint32n returns a pseudo-random uint32 in the half-open interval [0,n).
-func Uint64nUint32n(n uint32) uint32 {
+// If n == 0, it returns any uint32; see TryUint32n.
+func Uint32n(n uint32) uint32 {
 	if n&(n-1) == 0 { // n is power of two, can mask
 		return u32() & (n - 1)
 	}
@@ -101,12 +116,20 @@ func Uint64nUint32n(n uint32) uint32 {
 	return v % n
 }
 
+// Uint64nUint32n returns a pseudo-random uint32 in the half-open interval [0,n).
+//
+// Deprecated: Use Uint32n.
+func Uint64nUint32n(n uint32) uint32 {
+	return Uint32n(n)
+}
+
 // Uint64 returns a pseudo-random uint64.
 func Uint64() uint64 {
 	return u64()
 }
 
 // Uint64n returns a pseudo-random uint64 in the half-open interval [0,n).
+// If n == 0, it returns any uint64; see TryUint64n.
 func Uint64n(n uint64) uint64 {
 	if n&(n-1) == 0 { // n is power of two, can mask
 		return u64() & (n - 1)
@@ -136,23 +159,152 @@ func Jitter[T Real](v T, factor float64) T {
 	return T(float64(v) * (1 + (factor * (2*r - 1))))
 }
 
+// Between returns a pseudo-random value in the closed interval [lo,hi].
+// It handles any range, including the full range of the type.
+// It panics if lo > hi.
+func Between[T Real](lo, hi T) T {
+	if lo > hi {
+		panic("fastrand.Between: invalid argument")
+	}
+	// Two's complement arithmetic gives the width of the
+	// interval for both signed and unsigned types.
+	return T(uint64Between(uint64(lo), uint64(hi)))
+}
+
 // Shuffle pseudo-randomizes the order of elements in s.
 func Shuffle[E any](s []E) {
 	// Fisher-Yates shuffle: https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
-	i := len(s) - 1
-	// Shuffle really ought not be called with slice indices that requires more than 31 bits.
-	// Nevertheless, handle it as best we can.
-	for ; i >= maxInt32-1; i-- {
-		j := Int63n(int64(i + 1))
-		s[i], s[j] = s[j], s[i]
+	if bits.UintSize == 32 {
+		// Native 32-bit indices.
+		for i := len(s) - 1; i > 0; i-- {
+			j := Uint32n(uint32(i + 1))
+			s[i], s[j] = s[j], s[i]
+		}
+		return
 	}
-	// Switch to 31-bit indices.
-	for ; i > 0; i-- {
-		j := Int31n(int32(i + 1))
+	for i := len(s) - 1; i > 0; i-- {
+		j := Uint64n(uint64(i + 1))
 		s[i], s[j] = s[j], s[i]
 	}
 }
 
+// ShuffleInterface pseudo-randomizes the order of elements in data
+// using only its Len and Swap methods.
+func ShuffleInterface(data sort.Interface) {
+	// Fisher-Yates shuffle: https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
+	for i := data.Len() - 1; i > 0; i-- {
+		data.Swap(i, intn(i+1))
+	}
+}
+
+// Pick returns a pseudo-random element of s.
+// It panics if s is empty.
+func Pick[E any](s []E) E {
+	if len(s) == 0 {
+		panic("fastrand.Pick: empty slice")
+	}
+	return s[intn(len(s))]
+}
+
+// Choice returns one of the options, chosen pseudo-randomly.
+// For example:
+//
+//	region := fastrand.Choice("us-east", "us-west", "eu-west")
+//
+// It panics if there are no options.
+func Choice[E any](options ...E) E {
+	if len(options) == 0 {
+		panic("fastrand.Choice: no options")
+	}
+	return options[intn(len(options))]
+}
+
+// PickMapKey returns a pseudo-random key of m.
+// It panics if m is empty.
+func PickMapKey[K comparable, V any](m map[K]V) K {
+	k, _ := pickMapEntry(m, "fastrand.PickMapKey: empty map")
+	return k
+}
+
+// PickMapEntry returns a pseudo-random key and its value from m.
+// It panics if m is empty.
+func PickMapEntry[K comparable, V any](m map[K]V) (K, V) {
+	return pickMapEntry(m, "fastrand.PickMapEntry: empty map")
+}
+
+func pickMapEntry[K comparable, V any](m map[K]V, msg string) (K, V) {
+	if len(m) == 0 {
+		panic(msg)
+	}
+	// Map iteration order is unspecified and not uniformly distributed,
+	// so draw an index and walk to it.
+	i := intn(len(m))
+	for k, v := range m {
+		if i == 0 {
+			return k, v
+		}
+		i--
+	}
+	panic("unreachable")
+}
+
+// PickSeq returns a pseudo-random element of seq and true,
+// or the zero value and false if seq is empty.
+// It consumes seq in its entirety.
+func PickSeq[E any](seq iter.Seq[E]) (E, bool) {
+	// Reservoir sampling with a reservoir of size one:
+	// the nth element replaces the selection with probability 1/n.
+	var (
+		pick E
+		n    int
+	)
+	for e := range seq {
+		n++
+		if intn(n) == 0 {
+			pick = e
+		}
+	}
+	return pick, n > 0
+}
+
+// intn returns a non-negative pseudo-random int in the half-open interval [0,n).
+// It panics if n <= 0.
+func intn(n int) int {
+	if n <= maxInt32 {
+		return int(Int31n(int32(n)))
+	}
+	return int(Int63n(int64(n)))
+}
+
+// ShuffledSlice collects the values of seq into a new slice
+// in pseudo-random order.
+func ShuffledSlice[E any](seq iter.Seq[E]) []E {
+	// Inside-out Fisher-Yates shuffle: https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle#The_%22inside-out%22_algorithm
+	var s []E
+	for e := range seq {
+		i := len(s)
+		j := intn(i + 1)
+		s = append(s, e)
+		s[i], s[j] = s[j], e
+	}
+	return s
+}
+
+// int64Between returns a pseudo-random int64 in the closed interval [lo,hi].
+// It requires lo <= hi.
+func int64Between(lo, hi int64) int64 {
+	return lo + int64(uint64Between(0, uint64(hi-lo))) // the width always fits in uint64
+}
+
+// uint64Between returns a pseudo-random uint64 in the closed interval [lo,hi].
+// It requires lo <= hi.
+func uint64Between(lo, hi uint64) uint64 {
+	if w := hi - lo; w < maxUint64 {
+		return lo + Uint64n(w+1)
+	}
+	return u64()
+}
+
 var ioReader io.Reader = &reader{}
 
 // Reader returns an io.Reader that fills the read buffer with
@@ -168,6 +320,61 @@ func (*reader) Read(p []byte) (int, error) {
 	return len(p), nil
 }
 
+var (
+	hexIOReader    io.Reader = &hexReader{}
+	base64IOReader io.Reader = &base64Reader{}
+)
+
+// HexReader returns an io.Reader that fills the read buffer with
+// pseudo-random lowercase hexadecimal characters and never returns an error.
+func HexReader() io.Reader {
+	return hexIOReader
+}
+
+type hexReader struct{}
+
+func (*hexReader) Read(p []byte) (int, error) {
+	appendHex(p[:0], len(p))
+	return len(p), nil
+}
+
+// Base64Reader returns an io.Reader that fills the read buffer with
+// pseudo-random characters of the standard base64 alphabet (RFC 4648 §4),
+// without padding, and never returns an error. Any stream of a length
+// divisible by four may be decoded with base64.StdEncoding.
+func Base64Reader() io.Reader {
+	return base64IOReader
+}
+
+type base64Reader struct{}
+
+func (*base64Reader) Read(p []byte) (int, error) {
+	appendASCII(p[:0], len(p), stdBase64Alphabet)
+	return len(p), nil
+}
+
+// WriteTo writes n pseudo-random bytes to w, or writes until w returns
+// an error if n is negative. It returns the number of bytes written and
+// any error encountered, such as to stream bytes to an external
+// statistical test suite.
+func WriteTo(w io.Writer, n int64) (int64, error) {
+	buf := make([]byte, 64<<10)
+	var written int64
+	for n < 0 || written < n {
+		p := buf
+		if n >= 0 && n-written < int64(len(p)) {
+			p = p[:n-written]
+		}
+		Fill(p)
+		m, err := w.Write(p)
+		written += int64(m)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
 // Fill fills b with pseudo-random bytes.
 func Fill(p []byte) {
 	for len(p) >= 8 {
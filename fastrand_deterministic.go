@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build deterministic
+
+package fastrand
+
+import (
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// seeded is the generator selected by the deterministic build tag.
+// See the package documentation.
+var seeded = func() struct {
+	sync.Mutex
+	*rand.PCG
+} {
+	var seed uint64
+	if s, ok := os.LookupEnv("FASTRAND_SEED"); ok {
+		// Accept signed seeds, too, as fastrandtest does.
+		if v, err := strconv.ParseInt(s, 0, 64); err == nil {
+			seed = uint64(v)
+		} else if v, err := strconv.ParseUint(s, 0, 64); err == nil {
+			seed = v
+		} else {
+			panic("fastrand: invalid FASTRAND_SEED: " + s)
+		}
+	}
+	return struct {
+		sync.Mutex
+		*rand.PCG
+	}{PCG: rand.NewPCG(seed, seed)}
+}()
+
+func u32() uint32 {
+	return uint32(u64() >> 32)
+}
+
+func u64() uint64 {
+	seeded.Lock()
+	defer seeded.Unlock()
+	return seeded.Uint64()
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build safe && !deterministic
+
+package fastrand
+
+import "hash/maphash"
+
+func u32() uint32 {
+	return uint32(maphash.Bytes(maphash.MakeSeed(), nil) >> 32)
+}
+
+func u64() uint64 {
+	return maphash.Bytes(maphash.MakeSeed(), nil)
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+//go:build !safe && !deterministic
+
+package fastrand
+
+import (
+	_ "unsafe" // for go:linkname
+)
+
+//go:linkname u32 runtime.fastrand
+func u32() uint32
+
+//go:linkname u64 runtime.fastrand64
+func u64() uint64
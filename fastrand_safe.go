@@ -8,24 +8,18 @@
 
 package fastrand
 
-import "hash/maphash"
-
-func u32() uint32 {
-	return uint32(maphash.Bytes(maphash.MakeSeed(), nil) >> 32)
-}
-
-func u64() uint64 {
-	return maphash.Bytes(maphash.MakeSeed(), nil)
-}
-
 func putU64(p []byte, v uint64) {
 	_ = p[7] // Early bounds check to guarantee safety of writes below.
-	b[0] = byte(v)
-	b[1] = byte(v >> 8)
-	b[2] = byte(v >> 16)
-	b[3] = byte(v >> 24)
-	b[4] = byte(v >> 32)
-	b[5] = byte(v >> 40)
-	b[6] = byte(v >> 48)
-	b[7] = byte(v >> 56)
+	p[0] = byte(v)
+	p[1] = byte(v >> 8)
+	p[2] = byte(v >> 16)
+	p[3] = byte(v >> 24)
+	p[4] = byte(v >> 32)
+	p[5] = byte(v >> 40)
+	p[6] = byte(v >> 48)
+	p[7] = byte(v >> 56)
+}
+
+func bytesToString(b []byte) string {
+	return string(b)
 }
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"testing"
+
+	"bursavich.dev/fastrand/randtest"
+)
+
+func TestPickMapOne(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if k := PickMapKey(m); k != "a" {
+		t.Errorf("PickMapKey(%v) = %q; want %q", m, k, "a")
+	}
+	if k, v := PickMapEntry(m); k != "a" || v != 1 {
+		t.Errorf("PickMapEntry(%v) = %q, %d; want %q, %d", m, k, v, "a", 1)
+	}
+}
+
+func TestPickMapEmpty(t *testing.T) {
+	for name, fn := range map[string]func(){
+		"PickMapKey":   func() { PickMapKey(map[string]int{}) },
+		"PickMapEntry": func() { PickMapEntry(map[string]int(nil)) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s of empty map didn't panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestPickMapUniform(t *testing.T) {
+	m := make(map[uint64]uint64)
+	for i := uint64(0); i < 10; i++ {
+		m[i] = i
+	}
+	randtest.Uniform(t, func() uint64 { return PickMapKey(m) }, len(m))
+	randtest.Uniform(t, func() uint64 {
+		k, v := PickMapEntry(m)
+		if k != v {
+			t.Fatalf("PickMapEntry returned key %d with value %d", k, v)
+		}
+		return v
+	}, len(m))
+}
@@ -12,12 +12,10 @@ import (
 	"unsafe"
 )
 
-//go:linkname u32 runtime.fastrand
-func u32() uint32
-
-//go:linkname u64 runtime.fastrand64
-func u64() uint64
-
 func putU64(p []byte, v uint64) {
 	*(*uint64)(unsafe.Pointer(&p[0])) = v
 }
+
+func bytesToString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
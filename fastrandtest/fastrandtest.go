@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package fastrandtest provides reproducible randomness for tests.
+//
+// Package fastrand can't be seeded, so tests that need to reproduce a
+// failure draw from a seeded math/rand/v2 generator instead. The values
+// of its source for a given seed are fixed by the PCG algorithm, and the
+// seeds chosen for test names won't change within this major version.
+// Check verifies both.
+package fastrandtest
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// SeedEnv is the name of the environment variable that overrides
+// the seeds chosen by Seed, such as to reproduce a failure.
+const SeedEnv = "FASTRAND_SEED"
+
+// Seed returns a math/rand/v2 generator for the test with a seed derived from
+// its name, so that each test draws a distinct but stable sequence of values.
+// If the SeedEnv environment variable is set, its value is used as the seed
+// instead. The seed is logged if the test fails.
+func Seed(tb testing.TB) *rand.Rand {
+	tb.Helper()
+	seed := nameSeed(tb.Name())
+	if s, ok := os.LookupEnv(SeedEnv); ok {
+		v, err := parseSeed(s)
+		if err != nil {
+			tb.Fatalf("fastrandtest: invalid %s: %q", SeedEnv, s)
+		}
+		seed = v
+	}
+	return WithSeed(tb, seed)
+}
+
+// WithSeed returns a math/rand/v2 generator for the test with the given seed.
+// Its source is a PCG seeded with seed for both of its seeds.
+// The seed is logged if the test fails.
+func WithSeed(tb testing.TB, seed uint64) *rand.Rand {
+	tb.Helper()
+	hooks.Lock()
+	fns := hooks.fns
+	hooks.Unlock()
+	for _, fn := range fns {
+		fn(tb.Name(), seed)
+	}
+	tb.Cleanup(func() {
+		if tb.Failed() {
+			tb.Logf("fastrandtest: reproduce with %s=%d", SeedEnv, seed)
+		}
+	})
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// parseSeed parses a signed or unsigned 64-bit seed, as does
+// fastrand's deterministic build, which shares the SeedEnv variable.
+func parseSeed(s string) (uint64, error) {
+	if v, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return uint64(v), nil
+	}
+	return strconv.ParseUint(s, 0, 64)
+}
+
+func nameSeed(name string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return h.Sum64()
+}
+
+var hooks struct {
+	sync.Mutex
+	fns []func(name string, seed uint64)
+}
+
+// OnSeed registers fn to be called with the name of the test and its seed
+// whenever Seed or WithSeed is called, such as to record the seeds of a
+// CI run. It's typically called from TestMain.
+func OnSeed(fn func(name string, seed uint64)) {
+	hooks.Lock()
+	defer hooks.Unlock()
+	// Copy on write, so that snapshots taken by WithSeed are never modified.
+	hooks.fns = append(hooks.fns[:len(hooks.fns):len(hooks.fns)], fn)
+}
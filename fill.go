@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	fillMaxDepth = 8   // nesting depth beyond which pointers, slices, and maps are left nil
+	fillMaxLen   = 8   // default maximum length of strings, slices, and maps
+	fillNilProb  = 0.1 // probability that a pointer, slice, or map is left nil
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	fillMinTime  = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	fillTimeSpan = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Sub(fillMinTime)
+)
+
+// FillValue populates the value pointed to by v with pseudo-random values.
+// It recursively fills the exported fields of structs and the elements of
+// arrays, slices, and maps, and it allocates pointers. Pointers, slices, and
+// maps are occasionally left nil and are always left nil beyond a nesting
+// depth of 8, so recursive types are finite. A time.Time is set between the
+// years 2000 and 2030. Interfaces, channels, and functions are left unchanged.
+//
+// Struct fields may be configured with a comma-separated "fastrand" tag:
+//
+//   - "-" leaves the field unchanged.
+//   - "min=X" and "max=Y" bound numbers to the closed interval [X,Y]
+//     (half-open [X,Y) for floating-point numbers), or bound the length of
+//     strings, slices, and maps.
+//   - "enum=A|B|C" chooses a string or number uniformly among the given values.
+//
+// For example:
+//
+//	type User struct {
+//		Name  string `fastrand:"min=1,max=32"`
+//		Age   int    `fastrand:"min=0,max=120"`
+//		Role  string `fastrand:"enum=admin|user|guest"`
+//		Notes string `fastrand:"-"`
+//	}
+//
+// It panics if v is not a non-nil pointer or if a tag is invalid.
+func FillValue(v any) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		panic("fastrand.FillValue: non-pointer or nil argument")
+	}
+	fillValue(rv.Elem(), fillTag{}, 0)
+}
+
+type fillTag struct {
+	min, max string
+	enum     []string
+}
+
+func parseFillTag(f reflect.StructField) (tag fillTag, skip bool) {
+	s, ok := f.Tag.Lookup("fastrand")
+	if !ok {
+		return tag, false
+	}
+	if s == "-" {
+		return tag, true
+	}
+	for _, kv := range strings.Split(s, ",") {
+		k, v, _ := strings.Cut(kv, "=")
+		switch k {
+		case "min":
+			tag.min = v
+		case "max":
+			tag.max = v
+		case "enum":
+			tag.enum = strings.Split(v, "|")
+		default:
+			panic("fastrand.FillValue: invalid tag on field " + f.Name + ": " + kv)
+		}
+	}
+	return tag, false
+}
+
+func fillValue(v reflect.Value, tag fillTag, depth int) {
+	if v.Type() == timeType {
+		v.Set(reflect.ValueOf(fillMinTime.Add(time.Duration(Int63n(int64(fillTimeSpan))))))
+		return
+	}
+	if len(tag.enum) > 0 {
+		fillEnum(v, tag.enum)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(u32()&1 == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lo, hi := int64(math.MinInt64)>>(64-v.Type().Bits()), int64(math.MaxInt64)>>(64-v.Type().Bits())
+		lo, hi = parseFillInt(tag.min, lo), parseFillInt(tag.max, hi)
+		if lo > hi || v.OverflowInt(lo) || v.OverflowInt(hi) {
+			panic("fastrand.FillValue: invalid range")
+		}
+		v.SetInt(int64Between(lo, hi))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lo, hi := parseFillUint(tag.min, 0), parseFillUint(tag.max, maxUint64>>(64-v.Type().Bits()))
+		if lo > hi || v.OverflowUint(hi) {
+			panic("fastrand.FillValue: invalid range")
+		}
+		v.SetUint(uint64Between(lo, hi))
+	case reflect.Float32, reflect.Float64:
+		if tag.min == "" && tag.max == "" {
+			v.SetFloat(NormFloat64() * 1000)
+			return
+		}
+		lo, hi := parseFillFloat(tag.min, 0), parseFillFloat(tag.max, 1)
+		if !(lo <= hi) || math.IsInf(hi-lo, 0) {
+			panic("fastrand.FillValue: invalid range")
+		}
+		v.SetFloat(lo + Float64()*(hi-lo))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(NormFloat64()*1000, NormFloat64()*1000))
+	case reflect.String:
+		v.SetString(AlphaNum(fillLen(tag)))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fillValue(v.Index(i), fillTag{}, depth+1)
+		}
+	case reflect.Slice:
+		if depth >= fillMaxDepth || Float64() < fillNilProb {
+			v.SetZero()
+			return
+		}
+		n := fillLen(tag)
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			fillValue(s.Index(i), fillTag{}, depth+1)
+		}
+		v.Set(s)
+	case reflect.Map:
+		if depth >= fillMaxDepth || Float64() < fillNilProb {
+			v.SetZero()
+			return
+		}
+		n := fillLen(tag)
+		m := reflect.MakeMapWithSize(v.Type(), n)
+		for i := 0; i < n; i++ {
+			k := reflect.New(v.Type().Key()).Elem()
+			fillValue(k, fillTag{}, depth+1)
+			e := reflect.New(v.Type().Elem()).Elem()
+			fillValue(e, fillTag{}, depth+1)
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	case reflect.Pointer:
+		if depth >= fillMaxDepth || Float64() < fillNilProb {
+			v.SetZero()
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		fillValue(p.Elem(), tag, depth+1)
+		v.Set(p)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			tag, skip := parseFillTag(f)
+			if skip {
+				continue
+			}
+			fillValue(v.Field(i), tag, depth+1)
+		}
+	}
+}
+
+func fillEnum(v reflect.Value, enum []string) {
+	s := Pick(enum)
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var x int64
+		if x, err = strconv.ParseInt(s, 0, v.Type().Bits()); err == nil {
+			v.SetInt(x)
+			return
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var x uint64
+		if x, err = strconv.ParseUint(s, 0, v.Type().Bits()); err == nil {
+			v.SetUint(x)
+			return
+		}
+	case reflect.Float32, reflect.Float64:
+		var x float64
+		if x, err = strconv.ParseFloat(s, v.Type().Bits()); err == nil {
+			v.SetFloat(x)
+			return
+		}
+	}
+	panic("fastrand.FillValue: invalid enum value: " + s)
+}
+
+func fillLen(tag fillTag) int {
+	lo, hi := parseFillInt(tag.min, 0), parseFillInt(tag.max, fillMaxLen)
+	if lo < 0 || lo > hi || hi > math.MaxInt32 {
+		panic("fastrand.FillValue: invalid length")
+	}
+	return int(int64Between(lo, hi))
+}
+
+func parseFillInt(s string, def int64) int64 {
+	if s == "" {
+		return def
+	}
+	v, err := strconv.ParseInt(s, 0, 64)
+	if err != nil {
+		panic("fastrand.FillValue: invalid integer: " + s)
+	}
+	return v
+}
+
+func parseFillUint(s string, def uint64) uint64 {
+	if s == "" {
+		return def
+	}
+	v, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		panic("fastrand.FillValue: invalid unsigned integer: " + s)
+	}
+	return v
+}
+
+func parseFillFloat(s string, def float64) float64 {
+	if s == "" {
+		return def
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		panic("fastrand.FillValue: invalid float: " + s)
+	}
+	return v
+}
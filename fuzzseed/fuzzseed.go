@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package fuzzseed generates diverse seed corpora for native Go fuzz tests.
+//
+// The seeds mix boundary values, pathological strings, and structured byte
+// blobs with pseudo-random values. They aren't reproducible, but the fuzzing
+// engine records any failing input in testdata/fuzz, so failures are.
+package fuzzseed
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"bursavich.dev/fastrand"
+)
+
+// Add adds n seed entries to f's corpus. Each entry has one argument per
+// value in types, of the same type, which must match the fuzz target's
+// parameters. For example:
+//
+//	fuzzseed.Add(f, 100, "", []byte(nil), int64(0))
+//	f.Fuzz(func(t *testing.T, s string, b []byte, n int64) { ... })
+//
+// It panics if any of the types aren't supported by the fuzzing engine.
+func Add(f *testing.F, n int, types ...any) {
+	f.Helper()
+	for _, args := range Seeds(n, types...) {
+		f.Add(args...)
+	}
+}
+
+// Seeds returns n seed entries as described by Add.
+// It panics if any of the types aren't supported by the fuzzing engine.
+func Seeds(n int, types ...any) [][]any {
+	seeds := make([][]any, n)
+	for i := range seeds {
+		args := make([]any, len(types))
+		for j, t := range types {
+			args[j] = value(t)
+		}
+		seeds[i] = args
+	}
+	return seeds
+}
+
+func value(t any) any {
+	switch t.(type) {
+	case string:
+		return str()
+	case []byte:
+		return blob()
+	case bool:
+		return fastrand.Uint32()&1 == 0
+	case float32:
+		return float32(float())
+	case float64:
+		return float()
+	case int:
+		return int(signed(math.MinInt, math.MaxInt))
+	case int8:
+		return int8(signed(math.MinInt8, math.MaxInt8))
+	case int16:
+		return int16(signed(math.MinInt16, math.MaxInt16))
+	case int32:
+		return int32(signed(math.MinInt32, math.MaxInt32))
+	case int64:
+		return signed(math.MinInt64, math.MaxInt64)
+	case uint:
+		return uint(unsigned(math.MaxUint))
+	case uint8:
+		return uint8(unsigned(math.MaxUint8))
+	case uint16:
+		return uint16(unsigned(math.MaxUint16))
+	case uint32:
+		return uint32(unsigned(math.MaxUint32))
+	case uint64:
+		return unsigned(math.MaxUint64)
+	default:
+		panic(fmt.Sprintf("fuzzseed: unsupported type %T", t))
+	}
+}
+
+// signed returns a boundary value half of the time and otherwise
+// a value of pseudo-random magnitude.
+func signed(min, max int64) int64 {
+	if fastrand.Uint32()&1 == 0 {
+		return fastrand.Pick([]int64{0, 1, -1, min, min + 1, max, max - 1, max / 2, min / 2})
+	}
+	v := int64(fastrand.Uint64() >> fastrand.Int31n(64))
+	if v < min || v > max {
+		v = v%max + 1
+	}
+	if fastrand.Uint32()&1 == 0 {
+		v = -v
+	}
+	return v
+}
+
+// unsigned returns a boundary value half of the time and otherwise
+// a value of pseudo-random magnitude.
+func unsigned(max uint64) uint64 {
+	if fastrand.Uint32()&1 == 0 {
+		return fastrand.Pick([]uint64{0, 1, 2, max, max - 1, max / 2, max/2 + 1})
+	}
+	v := fastrand.Uint64() >> fastrand.Int31n(64)
+	if v > max {
+		v %= max
+	}
+	return v
+}
+
+var floats = []float64{
+	0, math.Copysign(0, -1), 1, -1, 0.5, math.Pi,
+	math.Inf(1), math.Inf(-1), math.NaN(),
+	math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64,
+	math.MaxFloat32, math.SmallestNonzeroFloat32,
+	1 << 53, 1<<53 + 1, math.Nextafter(1, 2),
+}
+
+func float() float64 {
+	if fastrand.Uint32()&1 == 0 {
+		return fastrand.Pick(floats)
+	}
+	return fastrand.NormFloat64() * math.Pow(10, float64(fastrand.Int31n(41)-20))
+}
+
+func str() string {
+	n := length()
+	switch fastrand.Int31n(8) {
+	case 0:
+		return ""
+	case 1:
+		return fastrand.AlphaNum(n)
+	case 2:
+		return fastrand.ASCII(n)
+	case 3:
+		return fastrand.Unicode(n)
+	case 4:
+		return fastrand.UnicodeEdge(n)
+	case 5:
+		return fastrand.MutateWhitespace(fastrand.MutateCase(fastrand.Words(1+n%8, nil, " ")))
+	case 6:
+		// Invalid UTF-8.
+		return string(blob())
+	default:
+		return string(fastrand.JSON(fastrand.JSONOptions{MaxSize: 1 + n*8}))
+	}
+}
+
+func blob() []byte {
+	n := length()
+	switch fastrand.Int31n(6) {
+	case 0:
+		return nil
+	case 1:
+		return []byte{}
+	case 2:
+		// A repeated pattern.
+		pat := make([]byte, 1+fastrand.Int31n(8))
+		fastrand.Fill(pat)
+		b := make([]byte, 0, n+len(pat))
+		for len(b) < n {
+			b = append(b, pat...)
+		}
+		return b
+	case 3:
+		// A length-prefixed record, with a length that may lie.
+		b := make([]byte, 4+n)
+		fastrand.Fill(b[4:])
+		l := uint32(n)
+		if fastrand.Uint32()&3 == 0 {
+			l = uint32(unsigned(math.MaxUint32))
+		}
+		b[0], b[1], b[2], b[3] = byte(l>>24), byte(l>>16), byte(l>>8), byte(l)
+		return b
+	case 4:
+		b := make([]byte, n)
+		fastrand.FillCompressible(b, 0.5)
+		return b
+	default:
+		b := make([]byte, n)
+		fastrand.Fill(b)
+		return b
+	}
+}
+
+// length returns a pseudo-random length that favors short lengths
+// but is occasionally long.
+func length() int {
+	return int(fastrand.Int31n(1 << fastrand.Int31n(13)))
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fuzzseed
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+// A ByteSource is a math/rand/v2 source whose values are derived from the
+// bytes of a fuzz input, so that the fuzzing engine can steer the random
+// choices of code that accepts a *rand.Rand:
+//
+//	f.Fuzz(func(t *testing.T, data []byte) {
+//		r := rand.New(fuzzseed.NewByteSource(data))
+//		...
+//	})
+type ByteSource struct {
+	data  []byte
+	state uint64 // SplitMix64 state, once data is exhausted
+}
+
+var _ rand.Source = (*ByteSource)(nil)
+
+// NewByteSource returns a source that consumes data eight bytes at a time,
+// in little-endian order. Once data is exhausted, the values continue from
+// a generator seeded by data, rather than repeating a constant that the
+// rejection sampling of some rand.Rand methods could retry forever.
+func NewByteSource(data []byte) *ByteSource {
+	s := &ByteSource{data: data}
+	for _, b := range data {
+		s.state = s.state*31 + uint64(b)
+	}
+	return s
+}
+
+// Uint64 returns the next value.
+func (s *ByteSource) Uint64() uint64 {
+	if len(s.data) >= 8 {
+		v := binary.LittleEndian.Uint64(s.data)
+		s.data = s.data[8:]
+		return v
+	}
+	if len(s.data) > 0 {
+		var b [8]byte
+		copy(b[:], s.data)
+		s.data = nil
+		return binary.LittleEndian.Uint64(b[:])
+	}
+	s.state += 0x9e3779b97f4a7c15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Len returns the number of bytes of data that haven't been consumed.
+func (s *ByteSource) Len() int {
+	return len(s.data)
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package gen
+
+import "testing"
+
+// maxShrinkSteps is the number of times Minimize shrinks a value before giving up.
+const maxShrinkSteps = 1000
+
+// Minimize greedily shrinks v, which must fail, to a simpler value that still
+// fails: it repeatedly replaces v with the first candidate returned by g.Shrink
+// that fails, until none do.
+func Minimize[T any](g Gen[T], v T, fails func(T) bool) T {
+	for i := 0; i < maxShrinkSteps; i++ {
+		shrunk := false
+		for c := range g.Shrink(v) {
+			if fails(c) {
+				v, shrunk = c, true
+				break
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return v
+}
+
+// Check tests that prop holds for n values from g. If it doesn't,
+// it minimizes the failing value and reports it as a test error.
+func Check[T any](tb testing.TB, g Gen[T], n int, prop func(T) bool) {
+	tb.Helper()
+	for i := 0; i < n; i++ {
+		v := g.Next()
+		if prop(v) {
+			continue
+		}
+		fails := func(v T) bool { return !prop(v) }
+		tb.Errorf("gen.Check: property failed for %#v (shrunk from %#v)", Minimize(g, v, fails), v)
+		return
+	}
+}
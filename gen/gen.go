@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package gen provides composable generators of pseudo-random values
+// for property-based testing.
+package gen
+
+import (
+	"iter"
+	"maps"
+
+	"golang.org/x/exp/constraints"
+
+	"bursavich.dev/fastrand"
+)
+
+// A Gen generates pseudo-random values of type T and, optionally,
+// shrinks them to simpler values.
+type Gen[T any] struct {
+	next   func() T
+	shrink func(T) iter.Seq[T]
+}
+
+// New returns a generator of the values returned by next.
+func New[T any](next func() T) Gen[T] {
+	return Gen[T]{next: next}
+}
+
+// Next returns a pseudo-random value.
+func (g Gen[T]) Next() T {
+	return g.next()
+}
+
+// WithShrinker returns a copy of the generator that shrinks values with shrink,
+// which returns a sequence of simpler candidates for a value, simplest first.
+func (g Gen[T]) WithShrinker(shrink func(T) iter.Seq[T]) Gen[T] {
+	g.shrink = shrink
+	return g
+}
+
+// Shrink returns a sequence of simpler candidates for v, simplest first.
+// It's empty if the generator has no shrinker.
+func (g Gen[T]) Shrink(v T) iter.Seq[T] {
+	if g.shrink == nil {
+		return func(func(T) bool) {}
+	}
+	return g.shrink(v)
+}
+
+// Const returns a generator that always returns v.
+func Const[T any](v T) Gen[T] {
+	return New(func() T { return v })
+}
+
+// Int returns a generator of integers in the closed interval [lo,hi].
+// It panics if lo > hi.
+func Int[T constraints.Integer](lo, hi T) Gen[T] {
+	if lo > hi {
+		panic("gen.Int: invalid argument")
+	}
+	// Two's complement arithmetic gives the width of the
+	// interval for both signed and unsigned types.
+	width := uint64(hi) - uint64(lo)
+	return New(func() T {
+		if width == 1<<64-1 {
+			return T(fastrand.Uint64())
+		}
+		return T(uint64(lo) + fastrand.Uint64n(width+1))
+	}).WithShrinker(func(v T) iter.Seq[T] {
+		// Shrink toward zero, or the bound nearest to it, by halving the distance.
+		var zero T
+		target := min(max(zero, lo), hi)
+		return func(yield func(T) bool) {
+			if v == target || !yield(target) {
+				return
+			}
+			for d := (v - target) / 2; d != 0; d /= 2 {
+				if !yield(v - d) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Float returns a generator of floating-point numbers in the half-open interval [lo,hi).
+// It panics if lo > hi.
+func Float[T constraints.Float](lo, hi T) Gen[T] {
+	if !(lo <= hi) {
+		panic("gen.Float: invalid argument")
+	}
+	return New(func() T {
+		return lo + T(fastrand.Float64())*(hi-lo)
+	}).WithShrinker(func(v T) iter.Seq[T] {
+		// Shrink toward zero, or the bound nearest to it, by halving the distance.
+		target := min(max(0, lo), hi)
+		return func(yield func(T) bool) {
+			if v == target || !yield(target) {
+				return
+			}
+			d := (v - target) / 2
+			for i := 0; i < 16 && v-d != v; i++ {
+				if !yield(v - d) {
+					return
+				}
+				d /= 2
+			}
+		}
+	})
+}
+
+// Bool returns a generator of booleans.
+func Bool() Gen[bool] {
+	return New(func() bool { return fastrand.Uint32()&1 == 0 }).WithShrinker(func(v bool) iter.Seq[bool] {
+		return func(yield func(bool) bool) {
+			if v {
+				yield(false)
+			}
+		}
+	})
+}
+
+// String returns a generator of strings of minLen to maxLen characters
+// from alphabet, which is interpreted as a sequence of runes.
+// It panics if minLen < 0, minLen > maxLen, or alphabet is empty.
+func String(alphabet string, minLen, maxLen int) Gen[string] {
+	if minLen < 0 || minLen > maxLen || alphabet == "" {
+		panic("gen.String: invalid argument")
+	}
+	n := Int(minLen, maxLen)
+	return New(func() string { return fastrand.String(n.Next(), alphabet) }).WithShrinker(func(v string) iter.Seq[string] {
+		return func(yield func(string) bool) {
+			for r := range shrinkLen([]rune(v), minLen) {
+				if !yield(string(r)) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// SliceOf returns a generator of slices of minLen to maxLen elements from elem.
+// It panics if minLen < 0 or minLen > maxLen.
+func SliceOf[T any](elem Gen[T], minLen, maxLen int) Gen[[]T] {
+	if minLen < 0 || minLen > maxLen {
+		panic("gen.SliceOf: invalid argument")
+	}
+	n := Int(minLen, maxLen)
+	return New(func() []T {
+		s := make([]T, n.Next())
+		for i := range s {
+			s[i] = elem.Next()
+		}
+		return s
+	}).WithShrinker(func(v []T) iter.Seq[[]T] {
+		return func(yield func([]T) bool) {
+			for s := range shrinkLen(v, minLen) {
+				if !yield(s) {
+					return
+				}
+			}
+			for i := range v {
+				for e := range elem.Shrink(v[i]) {
+					s := append([]T(nil), v...)
+					s[i] = e
+					if !yield(s) {
+						return
+					}
+				}
+			}
+		}
+	})
+}
+
+// shrinkLen returns a sequence of copies of s with a range of its elements
+// removed, largest ranges first, that are at least minLen long.
+func shrinkLen[T any](s []T, minLen int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for size := len(s) - minLen; size > 0; size /= 2 {
+			for i := 0; i+size <= len(s); i += size {
+				t := make([]T, 0, len(s)-size)
+				t = append(append(t, s[:i]...), s[i+size:]...)
+				if !yield(t) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// MapOf returns a generator of maps with up to maxLen entries with keys from
+// key and values from val. Maps have fewer than minLen entries only if key
+// repeatedly generates duplicates.
+// It panics if minLen < 0 or minLen > maxLen.
+func MapOf[K comparable, V any](key Gen[K], val Gen[V], minLen, maxLen int) Gen[map[K]V] {
+	if minLen < 0 || minLen > maxLen {
+		panic("gen.MapOf: invalid argument")
+	}
+	n := Int(minLen, maxLen)
+	return New(func() map[K]V {
+		size := n.Next()
+		m := make(map[K]V, size)
+		for tries := 0; len(m) < size && tries < 10*size; tries++ {
+			m[key.Next()] = val.Next()
+		}
+		return m
+	}).WithShrinker(func(v map[K]V) iter.Seq[map[K]V] {
+		return func(yield func(map[K]V) bool) {
+			if len(v) <= minLen {
+				return
+			}
+			for k := range v {
+				m := maps.Clone(v)
+				delete(m, k)
+				if !yield(m) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// OneOf returns a generator that delegates to a uniformly chosen generator of gens.
+// Its values can't be shrunk.
+// It panics if gens is empty.
+func OneOf[T any](gens ...Gen[T]) Gen[T] {
+	if len(gens) == 0 {
+		panic("gen.OneOf: no generators")
+	}
+	return New(func() T { return fastrand.Pick(gens).Next() })
+}
+
+// Elements returns a generator of values chosen uniformly from values.
+// Its values can't be shrunk.
+// It panics if values is empty.
+func Elements[T any](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("gen.Elements: no values")
+	}
+	return New(func() T { return fastrand.Pick(values) })
+}
+
+// Map returns a generator of the results of f applied to values from g.
+// Its values can't be shrunk.
+func Map[T, U any](g Gen[T], f func(T) U) Gen[U] {
+	return New(func() U { return f(g.Next()) })
+}
+
+// maxFilterTries is the number of values a filtered generator tries
+// before giving up.
+const maxFilterTries = 1000
+
+// Filter returns a generator of the values from g that satisfy keep.
+// The generator panics if it can't find such a value after 1000 tries.
+func Filter[T any](g Gen[T], keep func(T) bool) Gen[T] {
+	return New(func() T {
+		for i := 0; i < maxFilterTries; i++ {
+			if v := g.Next(); keep(v) {
+				return v
+			}
+		}
+		panic("gen.Filter: too many values rejected")
+	}).WithShrinker(func(v T) iter.Seq[T] {
+		return func(yield func(T) bool) {
+			for c := range g.Shrink(v) {
+				if keep(c) && !yield(c) {
+					return
+				}
+			}
+		}
+	})
+}
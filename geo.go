@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// LatLong returns pseudo-random geographic coordinates in degrees, uniformly
+// distributed by area over the surface of a sphere. Latitude is in the closed
+// interval [-90,90] and longitude is in the half-open interval [-180,180).
+func LatLong() (lat, long float64) {
+	return LatLongIn(BoundingBox{South: -90, West: -180, North: 90, East: 180})
+}
+
+// A BoundingBox is a region of the surface of a sphere bounded by lines
+// of latitude and longitude, in degrees. If West > East, the box crosses
+// the antimeridian.
+type BoundingBox struct {
+	South, West, North, East float64
+}
+
+// LatLongIn returns pseudo-random geographic coordinates in degrees, uniformly
+// distributed by area within the bounding box. Longitude is normalized to
+// the half-open interval [-180,180).
+// It panics if the box is invalid.
+func LatLongIn(box BoundingBox) (lat, long float64) {
+	if !(-90 <= box.South && box.South <= box.North && box.North <= 90) ||
+		!(-180 <= box.West && box.West <= 180) || !(-180 <= box.East && box.East <= 180) {
+		panic("fastrand.LatLongIn: invalid argument")
+	}
+	// The area of a band of latitude is proportional to the difference
+	// of the sines of its bounds, so the sine of latitude is uniform.
+	lo, hi := math.Sin(box.South*math.Pi/180), math.Sin(box.North*math.Pi/180)
+	lat = math.Asin(lo+Float64()*(hi-lo)) * 180 / math.Pi
+	width := box.East - box.West
+	if width < 0 {
+		width += 360
+	}
+	long = box.West + Float64()*width
+	if long >= 180 {
+		long -= 360
+	}
+	return lat, long
+}
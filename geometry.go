@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// InDisk returns a pseudo-random point uniformly distributed
+// within a disk of radius r centered at the origin.
+// It panics if r is negative.
+func InDisk(r float64) (x, y float64) {
+	if !(r >= 0) {
+		panic("fastrand.InDisk: invalid argument")
+	}
+	return InAnnulus(0, r)
+}
+
+// InAnnulus returns a pseudo-random point uniformly distributed within
+// an annulus centered at the origin, between radii inner and outer.
+// It panics if inner is negative or greater than outer.
+func InAnnulus(inner, outer float64) (x, y float64) {
+	if !(inner >= 0 && inner <= outer) {
+		panic("fastrand.InAnnulus: invalid argument")
+	}
+	// The area within radius r grows with r², so r² is uniform.
+	r := math.Sqrt(inner*inner + Float64()*(outer*outer-inner*inner))
+	sin, cos := math.Sincos(2 * math.Pi * Float64())
+	return r * cos, r * sin
+}
+
+// UnitVec2 returns a pseudo-random unit vector uniformly distributed in direction.
+func UnitVec2() (x, y float64) {
+	sin, cos := math.Sincos(2 * math.Pi * Float64())
+	return cos, sin
+}
+
+// UnitVec3 returns a pseudo-random unit vector uniformly distributed in direction,
+// that is, a point uniformly distributed on the surface of the unit sphere.
+func UnitVec3() (x, y, z float64) {
+	// Marsaglia, "Choosing a Point from the Surface of a Sphere" (1972).
+	for {
+		u, v := 2*Float64()-1, 2*Float64()-1
+		s := u*u + v*v
+		if s >= 1 {
+			continue
+		}
+		f := 2 * math.Sqrt(1-s)
+		return u * f, v * f, 1 - 2*s
+	}
+}
+
+// OnSphere returns a pseudo-random point uniformly distributed on
+// the surface of a sphere of radius r centered at the origin.
+// It panics if r is negative.
+func OnSphere(r float64) (x, y, z float64) {
+	if !(r >= 0) {
+		panic("fastrand.OnSphere: invalid argument")
+	}
+	x, y, z = UnitVec3()
+	return r * x, r * y, r * z
+}
+
+// InBall returns a pseudo-random point uniformly distributed
+// within a ball of radius r centered at the origin.
+// It panics if r is negative.
+func InBall(r float64) (x, y, z float64) {
+	if !(r >= 0) {
+		panic("fastrand.InBall: invalid argument")
+	}
+	// The volume within radius r grows with r³, so r³ is uniform.
+	r *= math.Cbrt(Float64())
+	x, y, z = UnitVec3()
+	return r * x, r * y, r * z
+}
+
+// Quaternion returns a pseudo-random unit quaternion w + xi + yj + zk,
+// uniformly distributed over the rotations it represents.
+func Quaternion() (w, x, y, z float64) {
+	// Shoemake, "Uniform Random Rotations", Graphics Gems III (1992).
+	u := Float64()
+	a, b := math.Sqrt(1-u), math.Sqrt(u)
+	sin1, cos1 := math.Sincos(2 * math.Pi * Float64())
+	sin2, cos2 := math.Sincos(2 * math.Pi * Float64())
+	return b * cos2, a * sin1, a * cos1, b * sin2
+}
+
+// RotationMatrix returns a pseudo-random 3×3 rotation matrix,
+// uniformly distributed over all rotations.
+func RotationMatrix() [3][3]float64 {
+	w, x, y, z := Quaternion()
+	return [3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y)},
+		{2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x)},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y)},
+	}
+}
+
+// A Point is a point in the plane.
+type Point struct {
+	X, Y float64
+}
+
+// InTriangle returns a pseudo-random point uniformly distributed
+// within the triangle with vertices a, b, and c.
+func InTriangle(a, b, c Point) Point {
+	u, v := Float64(), Float64()
+	if u+v > 1 {
+		// Reflect the far half of the parallelogram onto the triangle.
+		u, v = 1-u, 1-v
+	}
+	return Point{
+		X: a.X + u*(b.X-a.X) + v*(c.X-a.X),
+		Y: a.Y + u*(b.Y-a.Y) + v*(c.Y-a.Y),
+	}
+}
+
+// InPolygon returns a pseudo-random point uniformly distributed within the
+// convex polygon with the given vertices, listed in order around its boundary.
+// It panics if there are fewer than three vertices or the polygon has no area.
+func InPolygon(vertices []Point) Point {
+	if len(vertices) < 3 {
+		panic("fastrand.InPolygon: invalid argument")
+	}
+	// Decompose the polygon into a fan of triangles sharing the first vertex
+	// and pick one with probability proportional to its area.
+	a := vertices[0]
+	areas := make([]float64, len(vertices)-2)
+	for i := range areas {
+		b, c := vertices[i+1], vertices[i+2]
+		areas[i] = math.Abs((b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y))
+	}
+	sumWeights(areas, "fastrand.InPolygon")
+	i := PickWeighted(areas)
+	return InTriangle(a, vertices[i+1], vertices[i+2])
+}
@@ -1,7 +1,5 @@
 module bursavich.dev/fastrand
 
-go 1.21
-
-toolchain go1.21.3
+go 1.23
 
 require golang.org/x/exp v0.0.0-20231006140011-7918f672742d
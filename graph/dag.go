@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package graph
+
+import "bursavich.dev/fastrand"
+
+// RandomDAG returns a directed acyclic graph with n nodes. The nodes are
+// put in a pseudo-random topological order and each edge from an earlier
+// node to a later one is present independently with probability p.
+// It takes time proportional to the number of nodes and edges.
+// It panics if n < 0 or if p is not in the closed interval [0,1].
+func RandomDAG(n int, p float64) *Graph {
+	if n < 0 || !(p >= 0 && p <= 1) {
+		panic("graph.RandomDAG: invalid argument")
+	}
+	g := ErdosRenyi(n, p)
+	g.Directed = true
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	fastrand.Shuffle(order)
+	for i, e := range g.Edges {
+		g.Edges[i] = Edge{order[e.From], order[e.To]}
+	}
+	return g
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package graph generates pseudo-random graphs.
+package graph
+
+import (
+	"math"
+
+	"bursavich.dev/fastrand"
+)
+
+// An Edge connects two nodes, identified by their indices.
+type Edge struct {
+	From, To int
+}
+
+// A Graph is a set of nodes, identified by the indices in the half-open
+// interval [0,N), and the edges between them.
+type Graph struct {
+	// N is the number of nodes.
+	N int
+	// Edges are the edges between nodes. If the graph is undirected,
+	// each edge is listed once with From < To.
+	Edges []Edge
+	// Directed indicates whether the edges are directed.
+	Directed bool
+}
+
+// Adjacency returns the adjacency lists of the graph: the neighbors
+// of node i are listed in the ith element. If the graph is directed,
+// only the targets of outgoing edges are listed.
+func (g *Graph) Adjacency() [][]int {
+	adj := make([][]int, g.N)
+	for _, e := range g.Edges {
+		adj[e.From] = append(adj[e.From], e.To)
+		if !g.Directed {
+			adj[e.To] = append(adj[e.To], e.From)
+		}
+	}
+	return adj
+}
+
+// ErdosRenyi returns an undirected Erdős–Rényi G(n,p) graph with n nodes,
+// in which each possible edge is present independently with probability p.
+// It takes time proportional to the number of nodes and edges.
+// It panics if n < 0 or if p is not in the closed interval [0,1].
+func ErdosRenyi(n int, p float64) *Graph {
+	if n < 0 || !(p >= 0 && p <= 1) {
+		panic("graph.ErdosRenyi: invalid argument")
+	}
+	g := &Graph{N: n}
+	switch p {
+	case 0:
+		return g
+	case 1:
+		for v := 1; v < n; v++ {
+			for w := 0; w < v; w++ {
+				g.Edges = append(g.Edges, Edge{w, v})
+			}
+		}
+		return g
+	}
+	// Geometric skipping over the lower triangle of the adjacency matrix:
+	// Batagelj and Brandes, "Efficient generation of large random networks" (2005).
+	logq := math.Log1p(-p)
+	cells := n * (n - 1) / 2
+	v, w := 1, -1
+	for v < n {
+		// The skip may exceed the range of int when p is tiny,
+		// so compare it with the remaining cells before converting.
+		skip := math.Floor(math.Log(1-fastrand.Float64()) / logq)
+		if skip >= float64(cells-1-(v*(v-1)/2+w)) {
+			break
+		}
+		w += 1 + int(skip)
+		for w >= v && v < n {
+			w -= v
+			v++
+		}
+		if v < n {
+			g.Edges = append(g.Edges, Edge{w, v})
+		}
+	}
+	return g
+}
+
+// BarabasiAlbert returns an undirected Barabási–Albert graph with n nodes,
+// grown by preferential attachment: each node after the first m attaches
+// to m distinct existing nodes chosen with probability proportional to
+// their degrees, producing a power-law degree distribution.
+// It panics if m < 1 or m >= n.
+func BarabasiAlbert(n, m int) *Graph {
+	if m < 1 || m >= n {
+		panic("graph.BarabasiAlbert: invalid argument")
+	}
+	g := &Graph{N: n, Edges: make([]Edge, 0, (n-m)*m)}
+	// Each node appears in repeated once per incident edge, so a uniform
+	// pick from it is proportional to degree.
+	repeated := make([]int, 0, 2*(n-m)*m)
+	targets := make([]int, m)
+	for i := range targets {
+		targets[i] = i
+	}
+	chosen := make(map[int]struct{}, m)
+	for src := m; src < n; src++ {
+		for _, t := range targets {
+			g.Edges = append(g.Edges, Edge{t, src})
+			repeated = append(repeated, t, src)
+		}
+		clear(chosen)
+		targets = targets[:0]
+		for len(targets) < m {
+			t := fastrand.Pick(repeated)
+			if _, ok := chosen[t]; !ok {
+				chosen[t] = struct{}{}
+				targets = append(targets, t)
+			}
+		}
+	}
+	return g
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package graph
+
+import "bursavich.dev/fastrand"
+
+// RandomTree returns an undirected tree with n nodes chosen uniformly
+// from all n^(n-2) labeled trees, by decoding a random Prüfer sequence.
+// It panics if n < 0.
+func RandomTree(n int) *Graph {
+	if n < 0 {
+		panic("graph.RandomTree: invalid argument")
+	}
+	g := &Graph{N: n}
+	if n < 2 {
+		return g
+	}
+	g.Edges = make([]Edge, 0, n-1)
+	seq := make([]int, n-2)
+	degree := make([]int, n)
+	for i := range seq {
+		seq[i] = int(fastrand.Int63n(int64(n)))
+		degree[seq[i]]++
+	}
+	for i := range degree {
+		degree[i]++
+	}
+	// Linear-time decoding: ptr scans for the smallest leaf,
+	// unless removing an edge exposes a smaller one.
+	ptr := 0
+	for degree[ptr] != 1 {
+		ptr++
+	}
+	leaf := ptr
+	for _, v := range seq {
+		g.Edges = append(g.Edges, undirected(leaf, v))
+		if degree[v]--; degree[v] == 1 && v < ptr {
+			leaf = v
+			continue
+		}
+		ptr++
+		for degree[ptr] != 1 {
+			ptr++
+		}
+		leaf = ptr
+	}
+	g.Edges = append(g.Edges, undirected(leaf, n-1))
+	return g
+}
+
+// RandomRootedTree returns a directed tree with n nodes rooted at node 0,
+// with edges from parents to children. Each node after the root is attached
+// to a uniformly chosen earlier node with fewer than maxChildren children,
+// so each node's index is greater than its parent's. If maxChildren is zero
+// or negative, the number of children is unlimited.
+// It panics if n < 0.
+func RandomRootedTree(n, maxChildren int) *Graph {
+	if n < 0 {
+		panic("graph.RandomRootedTree: invalid argument")
+	}
+	g := &Graph{N: n, Directed: true}
+	if n < 2 {
+		return g
+	}
+	g.Edges = make([]Edge, 0, n-1)
+	children := make([]int, n)
+	open := make([]int, 1, n) // nodes that may have more children
+	for v := 1; v < n; v++ {
+		i := int(fastrand.Int63n(int64(len(open))))
+		u := open[i]
+		g.Edges = append(g.Edges, Edge{u, v})
+		if children[u]++; children[u] == maxChildren {
+			open[i] = open[len(open)-1]
+			open = open[:len(open)-1]
+		}
+		open = append(open, v)
+	}
+	return g
+}
+
+func undirected(u, v int) Edge {
+	if u > v {
+		u, v = v, u
+	}
+	return Edge{u, v}
+}
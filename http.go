@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "net/http"
+
+const (
+	// tchar from RFC 9110 §5.6.2.
+	httpTokenChars = "!#$%&'*+-.^_`|~" + alphaNum
+	// VCHAR from RFC 5234 Appendix B.1.
+	visibleChars = PasswordSymbols + alphaNum
+	// field-vchar and whitespace from RFC 9110 §5.5.
+	headerValueChars = visibleChars + " \t"
+	// bcharsnospace from RFC 2046 §5.1.1.
+	boundaryChars = "'()+_,-./:=?" + alphaNum
+)
+
+// HTTPToken returns a pseudo-random HTTP token of n characters, as used for
+// methods and header field names, drawn uniformly from tchar (RFC 9110 §5.6.2).
+// It panics if n < 1.
+func HTTPToken(n int) string {
+	if n < 1 {
+		panic("fastrand.HTTPToken: invalid argument")
+	}
+	return bytesToString(appendASCII(make([]byte, 0, n), n, httpTokenChars))
+}
+
+// HTTPHeaderValue returns a pseudo-random HTTP header field value of n characters
+// (RFC 9110 §5.5). It consists of visible ASCII characters, spaces, and tabs,
+// and it neither begins nor ends with whitespace.
+// It panics if n < 0.
+func HTTPHeaderValue(n int) string {
+	if n < 0 {
+		panic("fastrand.HTTPHeaderValue: invalid argument")
+	}
+	return bytesToString(appendHeaderValue(make([]byte, 0, n), n))
+}
+
+func appendHeaderValue(b []byte, n int) []byte {
+	if n > 0 {
+		b = appendASCII(b, 1, visibleChars)
+	}
+	if n > 2 {
+		b = appendASCII(b, n-2, headerValueChars)
+	}
+	if n > 1 {
+		b = appendASCII(b, 1, visibleChars)
+	}
+	return b
+}
+
+// HTTPHeader returns n pseudo-random HTTP header fields. Each field name is
+// a canonicalized token of up to 16 characters and each value is a valid
+// field value of up to maxValueLen characters, as returned by HTTPHeaderValue.
+// Field names may repeat, in which case the field has multiple values.
+// It panics if n < 0 or maxValueLen < 0.
+func HTTPHeader(n, maxValueLen int) http.Header {
+	if n < 0 || maxValueLen < 0 {
+		panic("fastrand.HTTPHeader: invalid argument")
+	}
+	h := make(http.Header, n)
+	for i := 0; i < n; i++ {
+		name := http.CanonicalHeaderKey(HTTPToken(1 + intn(16)))
+		h[name] = append(h[name], HTTPHeaderValue(intn(maxValueLen+1)))
+	}
+	return h
+}
+
+// MIMEBoundary returns a pseudo-random multipart boundary of n characters
+// (RFC 2046 §5.1.1). It may contain spaces, but it never ends with one.
+// It panics if n < 1 or n > 70.
+func MIMEBoundary(n int) string {
+	if n < 1 || n > 70 {
+		panic("fastrand.MIMEBoundary: invalid argument")
+	}
+	b := make([]byte, 0, n)
+	b = appendASCII(b, n-1, boundaryChars+" ")
+	b = appendASCII(b, 1, boundaryChars)
+	return bytesToString(b)
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+// UUIDv4 returns a pseudo-random version 4 UUID as defined by RFC 9562.
+//
+// It is not suitable for identifiers that must be unguessable.
+func UUIDv4() [16]byte {
+	var u [16]byte
+	putU64(u[:8], u64())
+	putU64(u[8:], u64())
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // variant 10
+	return u
+}
+
+// UUIDv4String returns a pseudo-random version 4 UUID as defined by RFC 9562
+// in its canonical string form: xxxxxxxx-xxxx-4xxx-Vxxx-xxxxxxxxxxxx.
+//
+// It is not suitable for identifiers that must be unguessable.
+func UUIDv4String() string {
+	u := UUIDv4()
+	b := make([]byte, 36)
+	j := 0
+	for i, c := range u {
+		if i == 4 || i == 6 || i == 8 || i == 10 {
+			b[j] = '-'
+			j++
+		}
+		b[j] = hexDigits[c>>4]
+		b[j+1] = hexDigits[c&0xf]
+		j += 2
+	}
+	return bytesToString(b)
+}
+
+// ULID returns a ULID composed of the current time in milliseconds
+// and 80 pseudo-random bits, as defined by https://github.com/ulid/spec.
+// ULIDs generated within the same millisecond are not monotonic.
+//
+// It is not suitable for identifiers that must be unguessable.
+func ULID() [16]byte {
+	var u [16]byte
+	ms := uint64(time.Now().UnixMilli())
+	binary.BigEndian.PutUint64(u[:8], ms<<16|uint64(u32()&0xffff))
+	putU64(u[8:], u64())
+	return u
+}
+
+// ULIDString returns a ULID as defined by ULID in its canonical string form.
+func ULIDString() string {
+	return EncodeULID(ULID())
+}
+
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// EncodeULID returns the canonical 26-character Crockford base32 encoding of u.
+func EncodeULID(u [16]byte) string {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+	b := make([]byte, 26)
+	for i := range b {
+		// The encoding holds 130 bits, so the first character has two zero bits.
+		var v uint64
+		switch s := uint(5 * (len(b) - 1 - i)); {
+		case s >= 64:
+			v = hi >> (s - 64)
+		case s > 59:
+			v = lo>>s | hi<<(64-s)
+		default:
+			v = lo >> s
+		}
+		b[i] = crockfordBase32[v&0x1f]
+	}
+	return bytesToString(b)
+}
+
+const nanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+
+// NanoID returns a pseudo-random NanoID of n characters drawn uniformly from
+// the standard URL-safe alphabet [A-Za-z0-9_-]. The standard length is 21.
+// It panics if n < 0.
+//
+// It is not suitable for identifiers that must be unguessable.
+func NanoID(n int) string {
+	if n < 0 {
+		panic("fastrand.NanoID: invalid argument")
+	}
+	return bytesToString(appendASCII(make([]byte, 0, n), n, nanoIDAlphabet))
+}
+
+// ksuidEpoch is the KSUID epoch in Unix seconds: 2014-05-13T16:53:20Z.
+const ksuidEpoch = 1400000000
+
+// KSUID returns a KSUID composed of the current time in seconds
+// and 128 pseudo-random bits, as defined by https://github.com/segmentio/ksuid.
+//
+// It is not suitable for identifiers that must be unguessable.
+func KSUID() [20]byte {
+	var k [20]byte
+	binary.BigEndian.PutUint32(k[:4], uint32(time.Now().Unix()-ksuidEpoch))
+	putU64(k[4:12], u64())
+	putU64(k[12:], u64())
+	return k
+}
+
+// KSUIDString returns a KSUID as defined by KSUID in its canonical string form.
+func KSUIDString() string {
+	return EncodeKSUID(KSUID())
+}
+
+const ksuidBase62 = digits + upper + lower
+
+// EncodeKSUID returns the canonical 27-character base62 encoding of k.
+func EncodeKSUID(k [20]byte) string {
+	var parts [5]uint32
+	for i := range parts {
+		parts[i] = binary.BigEndian.Uint32(k[4*i:])
+	}
+	b := make([]byte, 27)
+	for i := len(b) - 1; i >= 0; i-- {
+		// Long division of the 160-bit big-endian value by 62.
+		var rem uint64
+		for j, p := range parts {
+			acc := rem<<32 | uint64(p)
+			parts[j] = uint32(acc / 62)
+			rem = acc % 62
+		}
+		b[i] = ksuidBase62[rem]
+	}
+	return bytesToString(b)
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"encoding/json"
+	"math"
+	"unicode"
+)
+
+// JSONOptions configures the generation of pseudo-random JSON values.
+// The zero value is ready to use and selects the defaults.
+type JSONOptions struct {
+	// MaxDepth is the maximum nesting depth of arrays and objects.
+	// If zero, the default of 4 is used.
+	// If negative, only scalar values are produced.
+	MaxDepth int
+	// MaxFanout is the maximum number of elements in an array or object.
+	// If zero or negative, the default of 8 is used.
+	MaxFanout int
+	// MaxStringLen is the maximum number of runes in a string or object key.
+	// If zero or negative, the default of 16 is used.
+	MaxStringLen int
+	// MaxSize is the approximate maximum size of the encoded value in bytes.
+	// Containers stop growing once it's exceeded.
+	// If zero or negative, the default of 4096 is used.
+	MaxSize int
+	// StringWeight, NumberWeight, BoolWeight, and NullWeight are the relative
+	// likelihoods of each kind of scalar value. If they're all zero, every
+	// kind is equally likely. It panics if any of them are negative.
+	StringWeight, NumberWeight, BoolWeight, NullWeight float64
+}
+
+// JSON returns the encoding of a pseudo-random JSON value.
+// See JSONValue for details.
+func JSON(opts JSONOptions) []byte {
+	b, err := json.Marshal(JSONValue(opts))
+	if err != nil {
+		panic("fastrand.JSON: " + err.Error()) // unreachable: values are always encodable
+	}
+	return b
+}
+
+// JSONValue returns a pseudo-random JSON value as decoded by encoding/json into an any:
+// map[string]any for objects, []any for arrays, string, float64, bool, or nil.
+// If the maximum depth is positive, the value is an array or object.
+// It panics if the options are invalid.
+func JSONValue(opts JSONOptions) any {
+	g := newJSONGen(opts)
+	if g.maxDepth == 0 {
+		return g.scalar()
+	}
+	return g.container(0)
+}
+
+type jsonGen struct {
+	maxDepth  int
+	maxFanout int
+	maxStrLen int
+	budget    int
+	scalars   []float64 // cumulative weights of string, number, bool, and null
+}
+
+func newJSONGen(opts JSONOptions) *jsonGen {
+	g := &jsonGen{
+		maxDepth:  opts.MaxDepth,
+		maxFanout: opts.MaxFanout,
+		maxStrLen: opts.MaxStringLen,
+		budget:    opts.MaxSize,
+	}
+	switch {
+	case g.maxDepth == 0:
+		g.maxDepth = 4
+	case g.maxDepth < 0:
+		g.maxDepth = 0
+	}
+	if g.maxFanout <= 0 {
+		g.maxFanout = 8
+	}
+	if g.maxStrLen <= 0 {
+		g.maxStrLen = 16
+	}
+	if g.budget <= 0 {
+		g.budget = 4096
+	}
+	weights := []float64{opts.StringWeight, opts.NumberWeight, opts.BoolWeight, opts.NullWeight}
+	if weights[0] == 0 && weights[1] == 0 && weights[2] == 0 && weights[3] == 0 {
+		weights = []float64{1, 1, 1, 1}
+	}
+	g.scalars = CumulativeWeights(weights)
+	return g
+}
+
+func (g *jsonGen) value(depth int) any {
+	if depth < g.maxDepth && g.budget > 0 && Float64() < 0.3 {
+		return g.container(depth)
+	}
+	return g.scalar()
+}
+
+func (g *jsonGen) container(depth int) any {
+	n := intn(g.maxFanout + 1)
+	g.budget -= 2
+	if Float64() < 0.5 {
+		a := make([]any, 0, n)
+		for i := 0; i < n && g.budget > 0; i++ {
+			a = append(a, g.value(depth+1))
+			g.budget--
+		}
+		return a
+	}
+	m := make(map[string]any, n)
+	for i := 0; i < n && g.budget > 0; i++ {
+		k := g.string()
+		m[k] = g.value(depth + 1)
+		g.budget -= len(k) + 4
+	}
+	return m
+}
+
+func (g *jsonGen) scalar() any {
+	switch PickCumulative(g.scalars) {
+	case 0:
+		s := g.string()
+		g.budget -= len(s) + 2
+		return s
+	case 1:
+		g.budget -= 8
+		return g.number()
+	case 2:
+		g.budget -= 5
+		return Float64() < 0.5
+	default:
+		g.budget -= 4
+		return nil
+	}
+}
+
+func (g *jsonGen) string() string {
+	n := intn(g.maxStrLen + 1)
+	switch x := Float64(); {
+	case x < 0.6:
+		return AlphaNum(n)
+	case x < 0.8:
+		// Includes quotes and backslashes, which must be escaped.
+		return ASCII(n)
+	case x < 0.9:
+		// Includes control characters, which must be escaped.
+		return String(n, "\x00\x01\b\f\n\r\t\x1f\"\\/ ")
+	default:
+		return Unicode(n, unicode.L, unicode.N, unicode.P, unicode.S)
+	}
+}
+
+func (g *jsonGen) number() float64 {
+	switch x := Float64(); {
+	case x < 0.4:
+		// Small integers.
+		return float64(Int31n(2001) - 1000)
+	case x < 0.6:
+		// Integers that are exactly representable as float64.
+		return float64(Int63n(1<<53) - 1<<52)
+	case x < 0.9:
+		return NormFloat64() * 1000
+	default:
+		// Extreme magnitudes, which are encoded with exponents.
+		v := math.Ldexp(Float64()+0.5, int(Int31n(2001)-1000))
+		if Float64() < 0.5 {
+			v = -v
+		}
+		return v
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+// A Markov is a discrete-time Markov chain that generates pseudo-random
+// sequences of states, each of which depends on the one before it.
+type Markov[S comparable] struct {
+	states []S
+	index  map[S]int
+	cum    [][]float64 // cumulative transition weights of each state
+}
+
+// NewMarkov returns a Markov chain over the given distinct states in which
+// the probability of a transition from states[i] to states[j] is proportional
+// to transitions[i][j].
+// It panics if the states aren't distinct, if transitions isn't a square
+// matrix of the same size, or if any of its rows isn't valid weights,
+// as described by CumulativeWeights.
+func NewMarkov[S comparable](states []S, transitions [][]float64) *Markov[S] {
+	if len(transitions) != len(states) {
+		panic("fastrand.NewMarkov: invalid transitions")
+	}
+	m := &Markov[S]{
+		states: states,
+		index:  make(map[S]int, len(states)),
+		cum:    make([][]float64, len(states)),
+	}
+	for i, s := range states {
+		if _, ok := m.index[s]; ok {
+			panic("fastrand.NewMarkov: duplicate state")
+		}
+		m.index[s] = i
+		if len(transitions[i]) != len(states) {
+			panic("fastrand.NewMarkov: invalid transitions")
+		}
+		sumWeights(transitions[i], "fastrand.NewMarkov")
+		m.cum[i] = CumulativeWeights(transitions[i])
+	}
+	return m
+}
+
+// LearnMarkov returns a Markov chain whose transition probabilities are the
+// frequencies of transitions in the example sequence. The sequence is treated
+// as cyclic, so that its last state transitions to its first and every state
+// has a successor. For example, a chain of characters learned from text:
+//
+//	m := fastrand.LearnMarkov([]rune(text))
+//	s := string(m.Walk([]rune(text)[0], 100))
+//
+// It panics if seq is empty.
+func LearnMarkov[S comparable](seq []S) *Markov[S] {
+	if len(seq) == 0 {
+		panic("fastrand.LearnMarkov: empty sequence")
+	}
+	var states []S
+	index := make(map[S]int)
+	for _, s := range seq {
+		if _, ok := index[s]; !ok {
+			index[s] = len(states)
+			states = append(states, s)
+		}
+	}
+	transitions := make([][]float64, len(states))
+	for i := range transitions {
+		transitions[i] = make([]float64, len(states))
+	}
+	for i, s := range seq {
+		next := seq[(i+1)%len(seq)]
+		transitions[index[s]][index[next]]++
+	}
+	return NewMarkov(states, transitions)
+}
+
+// States returns the states of the chain.
+func (m *Markov[S]) States() []S {
+	return append([]S(nil), m.states...)
+}
+
+// Next returns a pseudo-random successor of state.
+// It panics if state isn't a state of the chain.
+func (m *Markov[S]) Next(state S) S {
+	i, ok := m.index[state]
+	if !ok {
+		panic("fastrand.Markov.Next: unknown state")
+	}
+	return m.states[PickCumulative(m.cum[i])]
+}
+
+// Walk returns a pseudo-random sequence of n states that begins at start.
+// It panics if n < 0 or start isn't a state of the chain.
+func (m *Markov[S]) Walk(start S, n int) []S {
+	if n < 0 {
+		panic("fastrand.Markov.Walk: invalid argument")
+	}
+	i, ok := m.index[start]
+	if !ok {
+		panic("fastrand.Markov.Walk: unknown state")
+	}
+	seq := make([]S, n)
+	for k := range seq {
+		seq[k] = m.states[i]
+		i = PickCumulative(m.cum[i])
+	}
+	return seq
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// FillUniform fills dst with pseudo-random values in the half-open interval [lo,hi).
+// It panics if lo > hi or if either is not finite.
+func FillUniform(dst []float64, lo, hi float64) {
+	if !(lo <= hi) || math.IsInf(hi-lo, 0) {
+		panic("fastrand.FillUniform: invalid argument")
+	}
+	for i := range dst {
+		dst[i] = lo + Float64()*(hi-lo)
+	}
+}
+
+// FillNormal fills dst with normally distributed pseudo-random values
+// with the given mean and standard deviation.
+// It panics if stddev is negative.
+func FillNormal(dst []float64, mean, stddev float64) {
+	if !(stddev >= 0) {
+		panic("fastrand.FillNormal: invalid argument")
+	}
+	for i := range dst {
+		dst[i] = mean + NormFloat64()*stddev
+	}
+}
+
+// FillXavier fills dst with weights for a layer with fanIn inputs and fanOut outputs
+// using Xavier (Glorot) initialization: values are uniform in the interval [-a,a)
+// where a = sqrt(6/(fanIn+fanOut)). It suits layers with tanh or sigmoid activations.
+// It panics if fanIn < 1 or fanOut < 1.
+func FillXavier(dst []float64, fanIn, fanOut int) {
+	if fanIn < 1 || fanOut < 1 {
+		panic("fastrand.FillXavier: invalid argument")
+	}
+	a := math.Sqrt(6 / float64(fanIn+fanOut))
+	FillUniform(dst, -a, a)
+}
+
+// FillHe fills dst with weights for a layer with fanIn inputs using He (Kaiming)
+// initialization: values are normal with mean zero and standard deviation sqrt(2/fanIn).
+// It suits layers with ReLU activations.
+// It panics if fanIn < 1.
+func FillHe(dst []float64, fanIn int) {
+	if fanIn < 1 {
+		panic("fastrand.FillHe: invalid argument")
+	}
+	FillNormal(dst, 0, math.Sqrt(2/float64(fanIn)))
+}
+
+// Matrix returns a rows×cols matrix of zeros, whose rows share a single
+// contiguous backing array.
+// It panics if rows < 0 or cols < 0.
+func Matrix(rows, cols int) [][]float64 {
+	if rows < 0 || cols < 0 {
+		panic("fastrand.Matrix: invalid argument")
+	}
+	m := make([][]float64, rows)
+	backing := make([]float64, rows*cols)
+	for i := range m {
+		m[i] = backing[i*cols : (i+1)*cols : (i+1)*cols]
+	}
+	return m
+}
+
+// FillMatrix calls fill with each row of m. For example, to initialize
+// the weights of a layer with n inputs and k outputs:
+//
+//	w := fastrand.Matrix(k, n)
+//	fastrand.FillMatrix(w, func(row []float64) { fastrand.FillHe(row, n) })
+func FillMatrix(m [][]float64, fill func(row []float64)) {
+	for _, row := range m {
+		fill(row)
+	}
+}
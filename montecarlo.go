@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"math"
+	"sync"
+)
+
+// MonteCarlo returns the mean of n evaluations of f, which should draw
+// pseudo-random values, and the standard error of that estimate.
+// It panics if n < 2.
+func MonteCarlo(f func() float64, n int) (estimate, stderr float64) {
+	if n < 2 {
+		panic("fastrand.MonteCarlo: invalid argument")
+	}
+	var s moments
+	for i := 0; i < n; i++ {
+		s.add(f())
+	}
+	return s.mean, s.stderr()
+}
+
+// MonteCarloParallel is like MonteCarlo, but it evaluates f concurrently
+// in the given number of goroutines, so f must be safe for concurrent use.
+// The generator scales well to many cores, so the goroutines don't need
+// separate streams.
+// It panics if n < 2 or workers < 1.
+func MonteCarloParallel(f func() float64, n, workers int) (estimate, stderr float64) {
+	if n < 2 || workers < 1 {
+		panic("fastrand.MonteCarloParallel: invalid argument")
+	}
+	workers = min(workers, n)
+	parts := make([]moments, workers)
+	var wg sync.WaitGroup
+	for w := range parts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Divide n as evenly as possible.
+			for i := n * w / workers; i < n*(w+1)/workers; i++ {
+				parts[w].add(f())
+			}
+		}()
+	}
+	wg.Wait()
+	var s moments
+	for _, p := range parts {
+		s.merge(p)
+	}
+	return s.mean, s.stderr()
+}
+
+// moments accumulates the mean and variance of a sample,
+// following Welford's online algorithm.
+type moments struct {
+	n    float64
+	mean float64
+	m2   float64 // sum of squared deviations from the mean
+}
+
+func (s *moments) add(x float64) {
+	s.n++
+	d := x - s.mean
+	s.mean += d / s.n
+	s.m2 += d * (x - s.mean)
+}
+
+// merge combines the moments of two samples, following Chan et al.,
+// "Updating Formulae and a Pairwise Algorithm for Computing Sample Variances" (1979).
+func (s *moments) merge(o moments) {
+	if o.n == 0 {
+		return
+	}
+	n := s.n + o.n
+	d := o.mean - s.mean
+	s.mean += d * o.n / n
+	s.m2 += o.m2 + d*d*s.n*o.n/n
+	s.n = n
+}
+
+func (s *moments) stderr() float64 {
+	return math.Sqrt(s.m2 / (s.n - 1) / s.n)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"strings"
+	"unicode"
+)
+
+// MutateCase returns s with the case of each letter pseudo-randomly
+// set to upper or lower case. Except for the few letters whose case
+// mappings don't round-trip, such as the Turkish dotless i, the result is
+// equal to s under Unicode case-folding, as reported by strings.EqualFold.
+func MutateCase(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	var (
+		v    uint64
+		left int // number of unused bits in v
+	)
+	for _, r := range s {
+		if left == 0 {
+			v, left = u64(), 64
+		}
+		if v&1 == 0 {
+			r = unicode.ToUpper(r)
+		} else {
+			r = unicode.ToLower(r)
+		}
+		v >>= 1
+		left--
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+const mutateSpaceChars = " \t\r\n"
+
+// MutateWhitespace returns s with pseudo-random whitespace added to its
+// beginning and end, and with each run of whitespace within it replaced
+// by a pseudo-random non-empty run of whitespace. The result is equal to s
+// after trimming and collapsing whitespace, as with strings.Fields.
+func MutateWhitespace(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 8)
+	appendSpace := func(atLeast int) {
+		for n := atLeast + intn(4); n > 0; n-- {
+			b.WriteByte(mutateSpaceChars[intn(len(mutateSpaceChars))])
+		}
+	}
+	appendSpace(0)
+	for i, f := range strings.Fields(s) {
+		if i > 0 {
+			appendSpace(1)
+		}
+		b.WriteString(f)
+	}
+	appendSpace(0)
+	return b.String()
+}
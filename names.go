@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"bytes"
+	"path/filepath"
+)
+
+const (
+	maxHostnameLen = 253
+	maxLabelLen    = 15 // generated labels are shorter than the RFC limit of 63
+	lowerDigits    = lower + digits
+	labelChars     = lowerDigits + "-"
+)
+
+// Hostname returns a pseudo-random hostname with the given number of
+// dot-separated labels. Every label is valid according to RFC 1123:
+// it consists of lowercase letters, digits, and hyphens, and it neither
+// begins nor ends with a hyphen. Labels begin with a letter, so the
+// result is never mistaken for an IP address, and the total length
+// never exceeds 253 characters.
+// It panics if labels < 1 or labels > 127.
+func Hostname(labels int) string {
+	if labels < 1 || labels > (maxHostnameLen+1)/2 {
+		panic("fastrand.Hostname: invalid argument")
+	}
+	maxLen := min(maxLabelLen, (maxHostnameLen+1)/labels-1)
+	b := make([]byte, 0, labels*(maxLen+1))
+	for i := 0; i < labels; i++ {
+		if i > 0 {
+			b = append(b, '.')
+		}
+		b = appendLabel(b, 1+intn(maxLen))
+	}
+	return bytesToString(b)
+}
+
+// appendLabel appends a DNS label of n characters to b.
+func appendLabel(b []byte, n int) []byte {
+	b = appendASCII(b, 1, lower)
+	if n > 2 {
+		b = appendASCII(b, n-2, labelChars)
+	}
+	if n > 1 {
+		b = appendASCII(b, 1, lowerDigits)
+	}
+	return b
+}
+
+// exampleDomains are reserved for documentation by RFC 2606.
+var exampleDomains = []string{"example.com", "example.net", "example.org"}
+
+const emailAtomChars = lowerDigits + "+-_"
+
+// Limits on the lengths of the local part and the whole address
+// in a path of RFC 5321.
+const (
+	maxEmailLocalLen = 64
+	maxEmailLen      = 254
+)
+
+// Email returns a pseudo-random email address at one of the given domains,
+// or at example.com, example.net, or example.org if none are given.
+// The local part is a valid RFC 5322 dot-atom: one to three atoms of
+// lowercase letters, digits, and the characters "+-_", separated by
+// single dots, with no leading or trailing dot. The local part is shortened
+// if necessary to keep the address within 254 octets, the limit of RFC 5321.
+// It panics if the chosen domain is longer than 252 octets.
+func Email(domains ...string) string {
+	if len(domains) == 0 {
+		domains = exampleDomains
+	}
+	domain := Pick(domains)
+	limit := min(maxEmailLocalLen, maxEmailLen-1-len(domain))
+	if limit < 1 {
+		panic("fastrand.Email: domain too long")
+	}
+	atoms := 1 + intn(3)
+	b := make([]byte, 0, atoms*9+len(domain))
+	for i := 0; i < atoms; i++ {
+		if i > 0 {
+			b = append(b, '.')
+		}
+		b = appendASCII(b, 1, lowerDigits)
+		b = appendASCII(b, intn(8), emailAtomChars)
+	}
+	if len(b) > limit {
+		// The first byte isn't a dot and no dots are adjacent,
+		// so trimming a trailing dot leaves a valid dot-atom.
+		b = bytes.TrimSuffix(b[:limit], []byte{'.'})
+	}
+	b = append(b, '@')
+	b = append(b, domain...)
+	return bytesToString(b)
+}
+
+// filenameLen is the length of generated file names. Drawn from 36
+// characters, they hold over 62 bits of entropy and are longer than
+// any name reserved by Windows (e.g. "CON" or "LPT1").
+const filenameLen = 12
+
+// Filename returns a pseudo-random file name followed by ext, such as ".txt".
+// The name consists of lowercase letters and digits, so it is portable
+// across operating systems and file systems, including case-insensitive ones.
+func Filename(ext string) string {
+	b := make([]byte, 0, filenameLen+len(ext))
+	b = appendASCII(b, filenameLen, lowerDigits)
+	b = append(b, ext...)
+	return bytesToString(b)
+}
+
+// PathUnder returns dir joined with depth pseudo-random names as returned
+// by Filename. It doesn't create any files or directories.
+// It panics if depth < 1.
+func PathUnder(dir string, depth int) string {
+	if depth < 1 {
+		panic("fastrand.PathUnder: invalid argument")
+	}
+	elems := make([]string, depth+1)
+	elems[0] = dir
+	for i := 1; i <= depth; i++ {
+		elems[i] = Filename("")
+	}
+	return filepath.Join(elems...)
+}
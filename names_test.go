@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestEmail(t *testing.T) {
+	label := strings.Repeat("a", 63)
+	long := label + "." + label + "." + label + ".example"          // 199 octets
+	longest := label + "." + label + "." + label + "." + label[:60] // 252 octets
+	for _, domains := range [][]string{
+		nil,
+		{"example.test"},
+		{long},
+		{longest},              // leaves one octet for the local part
+		{longest[:240] + ".x"}, // leaves a short local part
+	} {
+		for i := 0; i < 1000; i++ {
+			addr := Email(domains...)
+			if len(addr) > 254 {
+				t.Fatalf("Email(%.20q...) = %q; longer than 254 octets", domains, addr)
+			}
+			local, _, _ := strings.Cut(addr, "@")
+			if len(local) < 1 || len(local) > 64 {
+				t.Fatalf("Email() = %q; local part of %d octets", addr, len(local))
+			}
+			if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") || strings.Contains(local, "..") {
+				t.Fatalf("Email() = %q; invalid dots in local part", addr)
+			}
+			if _, err := mail.ParseAddress(addr); err != nil {
+				t.Fatalf("Email() = %q; mail.ParseAddress: %v", addr, err)
+			}
+		}
+	}
+}
+
+func TestEmailDomainTooLong(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Email with a domain of 253 octets didn't panic")
+		}
+	}()
+	Email(strings.Repeat("a", 253))
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+)
+
+// MAC returns a pseudo-random 48-bit unicast MAC address
+// with the locally administered bit set.
+func MAC() net.HardwareAddr {
+	mac := make(net.HardwareAddr, 6)
+	fill(mac, u64())
+	mac[0] = (mac[0] &^ 0x01) | 0x02 // unicast, locally administered
+	return mac
+}
+
+// An AddrOption configures the selection of a pseudo-random IP address.
+type AddrOption func(*addrOptions)
+
+type addrOptions struct {
+	excludeNetwork   bool
+	excludeBroadcast bool
+}
+
+// ExcludeNetwork returns an AddrOption that excludes the address of
+// a prefix whose host bits are all zeros, i.e. the network address.
+func ExcludeNetwork() AddrOption {
+	return func(o *addrOptions) { o.excludeNetwork = true }
+}
+
+// ExcludeBroadcast returns an AddrOption that excludes the address of
+// a prefix whose host bits are all ones, i.e. the IPv4 broadcast address.
+func ExcludeBroadcast() AddrOption {
+	return func(o *addrOptions) { o.excludeBroadcast = true }
+}
+
+func newAddrOptions(opts []AddrOption) addrOptions {
+	var o addrOptions
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return o
+}
+
+// pickHost returns pseudo-random host bits for a prefix with the given number
+// of host bits (up to 128) as high and low words, respecting the options.
+// It panics with a message prefixed by name if the options exclude every address.
+func (o *addrOptions) pickHost(hostBits int, name string) (hi, lo uint64) {
+	if (hostBits == 0 && (o.excludeNetwork || o.excludeBroadcast)) ||
+		(hostBits == 1 && o.excludeNetwork && o.excludeBroadcast) {
+		panic(name + ": no addresses")
+	}
+	var hiMask, loMask uint64
+	if hostBits >= 64 {
+		hiMask, loMask = 1<<(hostBits-64)-1, 1<<64-1
+	} else {
+		loMask = 1<<hostBits - 1
+	}
+	for {
+		if hiMask != 0 {
+			hi = u64() & hiMask
+		}
+		lo = u64() & loMask
+		if o.excludeNetwork && hi == 0 && lo == 0 {
+			continue
+		}
+		if o.excludeBroadcast && hi == hiMask && lo == loMask {
+			continue
+		}
+		return hi, lo
+	}
+}
+
+// IPv4InPrefix returns a pseudo-random IPv4 address within p.
+// It panics if p is not a valid IPv4 prefix or if the options
+// exclude every address within it.
+func IPv4InPrefix(p netip.Prefix, opts ...AddrOption) netip.Addr {
+	if !p.IsValid() || !p.Addr().Is4() {
+		panic("fastrand.IPv4InPrefix: invalid prefix")
+	}
+	o := newAddrOptions(opts)
+	_, host := o.pickHost(32-p.Bits(), "fastrand.IPv4InPrefix")
+	a := p.Masked().Addr().As4()
+	v := binary.BigEndian.Uint32(a[:]) | uint32(host)
+	binary.BigEndian.PutUint32(a[:], v)
+	return netip.AddrFrom4(a)
+}
+
+// IPv6InPrefix returns a pseudo-random IPv6 address within p.
+// It panics if p is not a valid IPv6 prefix or if the options
+// exclude every address within it.
+func IPv6InPrefix(p netip.Prefix, opts ...AddrOption) netip.Addr {
+	if !p.IsValid() || !p.Addr().Is6() {
+		panic("fastrand.IPv6InPrefix: invalid prefix")
+	}
+	o := newAddrOptions(opts)
+	hostHi, hostLo := o.pickHost(128-p.Bits(), "fastrand.IPv6InPrefix")
+	a := p.Masked().Addr().As16()
+	hi := binary.BigEndian.Uint64(a[:8]) | hostHi
+	lo := binary.BigEndian.Uint64(a[8:]) | hostLo
+	binary.BigEndian.PutUint64(a[:8], hi)
+	binary.BigEndian.PutUint64(a[8:], lo)
+	return netip.AddrFrom16(a)
+}
+
+// IANA dynamic or private port range, as defined by RFC 6335.
+const (
+	minEphemeralPort = 49152
+	maxEphemeralPort = 65535
+)
+
+// AddrPort returns a pseudo-random address within p, as returned by
+// IPv4InPrefix or IPv6InPrefix, paired with a pseudo-random port
+// from the IANA ephemeral range 49152 through 65535.
+// It panics if p is not valid or if the options exclude every address within it.
+func AddrPort(p netip.Prefix, opts ...AddrOption) netip.AddrPort {
+	var addr netip.Addr
+	switch {
+	case !p.IsValid():
+		panic("fastrand.AddrPort: invalid prefix")
+	case p.Addr().Is4():
+		addr = IPv4InPrefix(p, opts...)
+	default:
+		addr = IPv6InPrefix(p, opts...)
+	}
+	return netip.AddrPortFrom(addr, Port(minEphemeralPort, maxEphemeralPort))
+}
+
+// Port returns a pseudo-random port in the closed interval [lo,hi].
+// If both lo and hi are zero, the IANA ephemeral range 49152 through 65535 is used.
+// It panics if lo > hi.
+func Port(lo, hi uint16) uint16 {
+	if lo > hi {
+		panic("fastrand.Port: invalid range")
+	}
+	if lo == 0 && hi == 0 {
+		lo, hi = minEphemeralPort, maxEphemeralPort
+	}
+	// The width of the closed interval may be 65536, which overflows uint16.
+	return lo + uint16(intn(int(hi-lo)+1))
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package noise implements gradient noise for procedural textures,
+// terrains, and smooth synthetic signals.
+//
+// Unlike the rest of fastrand, noise is deterministic: a noise function
+// always returns the same value for the same seed and coordinates.
+// To use a pseudo-random seed, pass fastrand.Uint64().
+//
+// The values for a given seed are stable: they won't change in future
+// releases of this major version, so they may be used in golden files.
+// Check verifies them against values pinned by this package.
+package noise
+
+// A Source is a smooth noise function of one, two, or three dimensions.
+// Its values are approximately in the closed interval [-1,1].
+type Source interface {
+	Noise1(x float64) float64
+	Noise2(x, y float64) float64
+	Noise3(x, y, z float64) float64
+}
+
+// Fractal is a Source that sums octaves of another Source, each with
+// increasing frequency and decreasing amplitude, to produce fractal
+// Brownian motion. The sum is normalized to keep its values approximately
+// in the closed interval [-1,1].
+type Fractal struct {
+	// Source is the noise of each octave.
+	Source Source
+	// Octaves is the number of octaves.
+	// If zero or negative, the default of 4 is used.
+	Octaves int
+	// Persistence is the ratio of amplitudes of successive octaves.
+	// If zero or negative, the default of 0.5 is used.
+	Persistence float64
+	// Lacunarity is the ratio of frequencies of successive octaves.
+	// If zero or negative, the default of 2 is used.
+	Lacunarity float64
+}
+
+// Noise1 returns the fractal noise at x.
+func (f *Fractal) Noise1(x float64) float64 {
+	return f.sum(func(freq float64) float64 { return f.Source.Noise1(x * freq) })
+}
+
+// Noise2 returns the fractal noise at (x, y).
+func (f *Fractal) Noise2(x, y float64) float64 {
+	return f.sum(func(freq float64) float64 { return f.Source.Noise2(x*freq, y*freq) })
+}
+
+// Noise3 returns the fractal noise at (x, y, z).
+func (f *Fractal) Noise3(x, y, z float64) float64 {
+	return f.sum(func(freq float64) float64 { return f.Source.Noise3(x*freq, y*freq, z*freq) })
+}
+
+func (f *Fractal) sum(noise func(freq float64) float64) float64 {
+	octaves, persistence, lacunarity := f.Octaves, f.Persistence, f.Lacunarity
+	if octaves <= 0 {
+		octaves = 4
+	}
+	if !(persistence > 0) {
+		persistence = 0.5
+	}
+	if !(lacunarity > 0) {
+		lacunarity = 2
+	}
+	var sum, total float64
+	amp, freq := 1.0, 1.0
+	for i := 0; i < octaves; i++ {
+		sum += amp * noise(freq)
+		total += amp
+		amp *= persistence
+		freq *= lacunarity
+	}
+	return sum / total
+}
+
+// permutation returns a pseudo-random permutation of [0,256), repeated twice
+// to avoid wrapping indices, determined by seed.
+func permutation(seed uint64) *[512]uint8 {
+	var p [512]uint8
+	for i := 0; i < 256; i++ {
+		p[i] = uint8(i)
+	}
+	for i := 255; i > 0; i-- {
+		seed += 0x9e3779b97f4a7c15 // SplitMix64
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		z ^= z >> 31
+		j := z % uint64(i+1)
+		p[i], p[j] = p[j], p[i]
+	}
+	copy(p[256:], p[:256])
+	return &p
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package noise
+
+import "math"
+
+// Perlin is a Source of Perlin's improved gradient noise.
+// Its values are zero at integer coordinates.
+type Perlin struct {
+	perm *[512]uint8
+}
+
+// NewPerlin returns Perlin noise determined by seed.
+func NewPerlin(seed uint64) *Perlin {
+	return &Perlin{perm: permutation(seed)}
+}
+
+// Noise1 returns the noise at x.
+func (p *Perlin) Noise1(x float64) float64 {
+	fx := math.Floor(x)
+	xi := int(fx) & 255
+	x -= fx
+	u := fade(x)
+	a := grad1(p.perm[xi], x)
+	b := grad1(p.perm[xi+1], x-1)
+	return lerp(u, a, b) * 2
+}
+
+// Noise2 returns the noise at (x, y).
+func (p *Perlin) Noise2(x, y float64) float64 {
+	fx, fy := math.Floor(x), math.Floor(y)
+	xi, yi := int(fx)&255, int(fy)&255
+	x, y = x-fx, y-fy
+	u, v := fade(x), fade(y)
+	perm := p.perm
+	a, b := int(perm[xi])+yi, int(perm[xi+1])+yi
+	return lerp(v,
+		lerp(u, grad2(perm[a], x, y), grad2(perm[b], x-1, y)),
+		lerp(u, grad2(perm[a+1], x, y-1), grad2(perm[b+1], x-1, y-1)),
+	)
+}
+
+// Noise3 returns the noise at (x, y, z).
+func (p *Perlin) Noise3(x, y, z float64) float64 {
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+	perm := p.perm
+	a := int(perm[xi]) + yi
+	aa, ab := int(perm[a])+zi, int(perm[a+1])+zi
+	b := int(perm[xi+1]) + yi
+	ba, bb := int(perm[b])+zi, int(perm[b+1])+zi
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad3(perm[aa], x, y, z), grad3(perm[ba], x-1, y, z)),
+			lerp(u, grad3(perm[ab], x, y-1, z), grad3(perm[bb], x-1, y-1, z)),
+		),
+		lerp(v,
+			lerp(u, grad3(perm[aa+1], x, y, z-1), grad3(perm[ba+1], x-1, y, z-1)),
+			lerp(u, grad3(perm[ab+1], x, y-1, z-1), grad3(perm[bb+1], x-1, y-1, z-1)),
+		),
+	)
+}
+
+func grad1(hash uint8, x float64) float64 {
+	if hash&1 == 0 {
+		return x
+	}
+	return -x
+}
+
+func grad2(hash uint8, x, y float64) float64 {
+	switch hash & 3 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	default:
+		return -x - y
+	}
+}
+
+func grad3(hash uint8, x, y, z float64) float64 {
+	// Perlin, "Improving Noise" (2002): the 12 vectors from the center
+	// of a cube to its edges, with 4 repeated to make 16.
+	h := hash & 15
+	u, v := y, z
+	if h < 8 {
+		u = x
+	}
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package noise
+
+import "math"
+
+// Simplex is a Source of Perlin's simplex noise. Compared to Perlin noise,
+// it has fewer directional artifacts and is cheaper in higher dimensions.
+//
+// The implementation follows Gustavson, "Simplex noise demystified" (2005),
+// except that the 3D kernel has a squared radius of 0.5 instead of 0.6,
+// which would make the noise discontinuous at simplex boundaries.
+type Simplex struct {
+	perm *[512]uint8
+}
+
+// NewSimplex returns simplex noise determined by seed.
+func NewSimplex(seed uint64) *Simplex {
+	return &Simplex{perm: permutation(seed)}
+}
+
+var (
+	skew2   = 0.5 * (math.Sqrt(3) - 1)
+	unskew2 = (3 - math.Sqrt(3)) / 6
+)
+
+const (
+	skew3   = 1.0 / 3
+	unskew3 = 1.0 / 6
+)
+
+// The 12 vectors from the center of a cube to its edges.
+var simplexGrads = [12][3]float64{
+	{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+	{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+	{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+}
+
+// Noise1 returns the noise at x.
+func (s *Simplex) Noise1(x float64) float64 {
+	fx := math.Floor(x)
+	i := int(fx) & 255
+	x0 := x - fx
+	x1 := x0 - 1
+	var n float64
+	if t := 1 - x0*x0; t > 0 {
+		t *= t
+		n += t * t * simplexGrad1(s.perm[i], x0)
+	}
+	if t := 1 - x1*x1; t > 0 {
+		t *= t
+		n += t * t * simplexGrad1(s.perm[i+1], x1)
+	}
+	return 0.395 * n
+}
+
+// Noise2 returns the noise at (x, y).
+func (s *Simplex) Noise2(x, y float64) float64 {
+	// Skew the input space to find the simplex cell.
+	k := (x + y) * skew2
+	fi, fj := math.Floor(x+k), math.Floor(y+k)
+	t := (fi + fj) * unskew2
+	x0, y0 := x-(fi-t), y-(fj-t)
+	// Find the middle corner of the simplex.
+	i1, j1 := 0, 1
+	if x0 > y0 {
+		i1, j1 = 1, 0
+	}
+	x1, y1 := x0-float64(i1)+unskew2, y0-float64(j1)+unskew2
+	x2, y2 := x0-1+2*unskew2, y0-1+2*unskew2
+
+	perm := s.perm
+	i, j := int(fi)&255, int(fj)&255
+	g0 := perm[i+int(perm[j])] % 12
+	g1 := perm[i+i1+int(perm[j+j1])] % 12
+	g2 := perm[i+1+int(perm[j+1])] % 12
+	n := simplexCorner2(g0, x0, y0) + simplexCorner2(g1, x1, y1) + simplexCorner2(g2, x2, y2)
+	return 70 * n
+}
+
+// Noise3 returns the noise at (x, y, z).
+func (s *Simplex) Noise3(x, y, z float64) float64 {
+	// Skew the input space to find the simplex cell.
+	k := (x + y + z) * skew3
+	fi, fj, fk := math.Floor(x+k), math.Floor(y+k), math.Floor(z+k)
+	t := (fi + fj + fk) * unskew3
+	x0, y0, z0 := x-(fi-t), y-(fj-t), z-(fk-t)
+	// Find the second and third corners of the simplex.
+	var i1, j1, k1, i2, j2, k2 int
+	if x0 >= y0 {
+		switch {
+		case y0 >= z0:
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 1, 0
+		case x0 >= z0:
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 0, 1
+		default:
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 1, 0, 1
+		}
+	} else {
+		switch {
+		case y0 < z0:
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 0, 1, 1
+		case x0 < z0:
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 0, 1, 1
+		default:
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 1, 1, 0
+		}
+	}
+	x1, y1, z1 := x0-float64(i1)+unskew3, y0-float64(j1)+unskew3, z0-float64(k1)+unskew3
+	x2, y2, z2 := x0-float64(i2)+2*unskew3, y0-float64(j2)+2*unskew3, z0-float64(k2)+2*unskew3
+	x3, y3, z3 := x0-1+3*unskew3, y0-1+3*unskew3, z0-1+3*unskew3
+
+	perm := s.perm
+	i, j, l := int(fi)&255, int(fj)&255, int(fk)&255
+	g0 := perm[i+int(perm[j+int(perm[l])])] % 12
+	g1 := perm[i+i1+int(perm[j+j1+int(perm[l+k1])])] % 12
+	g2 := perm[i+i2+int(perm[j+j2+int(perm[l+k2])])] % 12
+	g3 := perm[i+1+int(perm[j+1+int(perm[l+1])])] % 12
+	n := simplexCorner3(g0, x0, y0, z0) + simplexCorner3(g1, x1, y1, z1) +
+		simplexCorner3(g2, x2, y2, z2) + simplexCorner3(g3, x3, y3, z3)
+	return 76.88 * n
+}
+
+func simplexGrad1(hash uint8, x float64) float64 {
+	g := float64(1 + hash&7)
+	if hash&8 != 0 {
+		g = -g
+	}
+	return g * x
+}
+
+func simplexCorner2(g uint8, x, y float64) float64 {
+	t := 0.5 - x*x - y*y
+	if t <= 0 {
+		return 0
+	}
+	t *= t
+	return t * t * (simplexGrads[g][0]*x + simplexGrads[g][1]*y)
+}
+
+func simplexCorner3(g uint8, x, y, z float64) float64 {
+	t := 0.5 - x*x - y*y - z*z
+	if t <= 0 {
+		return 0
+	}
+	t *= t
+	return t * t * (simplexGrads[g][0]*x + simplexGrads[g][1]*y + simplexGrads[g][2]*z)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package otelrand provides OpenTelemetry trace samplers and ID generators
+// backed by fastrand, which avoid the mutex-protected math/rand generator
+// of the default ID generator on high-QPS services.
+//
+// It's a separate module, so that fastrand doesn't depend on OpenTelemetry.
+//
+// A deterministic sampler, like sdktrace.TraceIDRatioBased, derives its
+// decisions from trace IDs and draws no randomness of its own, so it makes
+// consistent decisions across services. Pair it with IDGenerator:
+//
+//	tp := sdktrace.NewTracerProvider(
+//		sdktrace.WithIDGenerator(otelrand.IDGenerator()),
+//		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0.01))),
+//	)
+//
+// Probability samples each span independently instead.
+package otelrand
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"bursavich.dev/fastrand"
+)
+
+type probabilitySampler struct {
+	sampler     fastrand.Sampler
+	description string
+}
+
+// Probability returns a sampler that records and samples each span
+// independently with probability p, regardless of its trace ID, so
+// that decisions aren't consistent across services. It's usually wrapped
+// with sdktrace.ParentBased to follow the decisions of remote parents.
+// It panics if p is not in the closed interval [0,1].
+func Probability(p float64) sdktrace.Sampler {
+	if !(p >= 0 && p <= 1) {
+		panic("otelrand.Probability: invalid probability")
+	}
+	return &probabilitySampler{
+		sampler:     fastrand.NewSampler(p),
+		description: fmt.Sprintf("Probability{%g}", p),
+	}
+}
+
+func (s *probabilitySampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	decision := sdktrace.Drop
+	if s.sampler.Sample() {
+		decision = sdktrace.RecordAndSample
+	}
+	return sdktrace.SamplingResult{
+		Decision:   decision,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+func (s *probabilitySampler) Description() string {
+	return s.description
+}
+
+type idGenerator struct{}
+
+// IDGenerator returns an ID generator that generates pseudo-random
+// trace and span IDs. It's safe for concurrent use without contention.
+func IDGenerator() sdktrace.IDGenerator {
+	return idGenerator{}
+}
+
+func (idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	var tid trace.TraceID
+	for !tid.IsValid() {
+		binary.LittleEndian.PutUint64(tid[:8], fastrand.Uint64())
+		binary.LittleEndian.PutUint64(tid[8:], fastrand.Uint64())
+	}
+	return tid, newSpanID()
+}
+
+func (idGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	return newSpanID()
+}
+
+func newSpanID() trace.SpanID {
+	var sid trace.SpanID
+	for !sid.IsValid() {
+		binary.LittleEndian.PutUint64(sid[:], fastrand.Uint64())
+	}
+	return sid
+}
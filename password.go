@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+// PasswordSymbols is the set of printable ASCII punctuation characters.
+const PasswordSymbols = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// A PasswordPolicy describes the composition of a generated password.
+type PasswordPolicy struct {
+	// Length is the total number of characters.
+	Length int
+	// MinUpper is the minimum number of characters from [A-Z].
+	MinUpper int
+	// MinLower is the minimum number of characters from [a-z].
+	MinLower int
+	// MinDigits is the minimum number of characters from [0-9].
+	MinDigits int
+	// MinSymbols is the minimum number of characters from Symbols.
+	MinSymbols int
+	// Symbols is the set of ASCII symbol characters that may be used,
+	// such as PasswordSymbols. If empty, no symbols are used.
+	Symbols string
+}
+
+// Password returns a pseudo-random string that conforms to the policy.
+// Characters beyond the required minimums are drawn uniformly from all
+// of the allowed characters.
+// It panics if the policy is invalid or can't be satisfied.
+//
+// Password is intended for test fixtures and for validating password policies.
+// The underlying generator is not cryptographically secure, so the result
+// must not be used as a real credential.
+func Password(policy PasswordPolicy) string {
+	p := policy
+	if p.Length < 0 || p.MinUpper < 0 || p.MinLower < 0 || p.MinDigits < 0 || p.MinSymbols < 0 ||
+		p.MinUpper+p.MinLower+p.MinDigits+p.MinSymbols > p.Length ||
+		(p.MinSymbols > 0 && p.Symbols == "") || !isASCII(p.Symbols) {
+		panic("fastrand.Password: invalid policy")
+	}
+	b := make([]byte, 0, p.Length)
+	b = appendASCII(b, p.MinUpper, upper)
+	b = appendASCII(b, p.MinLower, lower)
+	b = appendASCII(b, p.MinDigits, digits)
+	if p.MinSymbols > 0 {
+		b = appendASCII(b, p.MinSymbols, p.Symbols)
+	}
+	b = appendASCII(b, p.Length-len(b), alphaNum+p.Symbols)
+	Shuffle(b)
+	return bytesToString(b)
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// A WeightedPicker picks pseudo-random indices with probability proportional
+// to their weights. Picks take constant time and never block. Updates replace
+// the weights atomically and take time proportional to the number of weights.
+//
+// A WeightedPicker is safe for concurrent use.
+type WeightedPicker struct {
+	mu    sync.Mutex // serializes updates
+	table atomic.Pointer[aliasTable]
+}
+
+// NewWeightedPicker returns a WeightedPicker with the given weights.
+// It panics if any weight is negative, infinite, or NaN,
+// or if the weights don't have a positive finite sum.
+func NewWeightedPicker(weights []float64) *WeightedPicker {
+	var p WeightedPicker
+	p.table.Store(newAliasTable(slices.Clone(weights), "fastrand.NewWeightedPicker"))
+	return &p
+}
+
+// Pick returns a pseudo-random index chosen with probability proportional to its weight.
+// Indices with zero weight are never chosen.
+func (p *WeightedPicker) Pick() int {
+	return p.table.Load().pick()
+}
+
+// Len returns the number of weights.
+func (p *WeightedPicker) Len() int {
+	return len(p.table.Load().weights)
+}
+
+// Weights returns a copy of the current weights.
+func (p *WeightedPicker) Weights() []float64 {
+	return slices.Clone(p.table.Load().weights)
+}
+
+// SetWeights replaces all of the weights.
+// It panics if any weight is negative, infinite, or NaN,
+// or if the weights don't have a positive finite sum.
+func (p *WeightedPicker) SetWeights(weights []float64) {
+	t := newAliasTable(slices.Clone(weights), "fastrand.WeightedPicker.SetWeights")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.table.Store(t)
+}
+
+// SetWeight replaces the weight at index i.
+// It panics if i is out of range, if w is negative, infinite, or NaN,
+// or if the resulting weights don't have a positive finite sum.
+func (p *WeightedPicker) SetWeight(i int, w float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	weights := slices.Clone(p.table.Load().weights)
+	weights[i] = w
+	p.table.Store(newAliasTable(weights, "fastrand.WeightedPicker.SetWeight"))
+}
+
+// aliasTable is an immutable table for Walker's alias method.
+type aliasTable struct {
+	weights []float64
+	prob    []float64
+	alias   []int
+}
+
+// newAliasTable returns an alias table that retains weights.
+// It panics with a message prefixed by name if the weights are invalid.
+func newAliasTable(weights []float64, name string) *aliasTable {
+	// Vose's alias method: https://www.keithschwarz.com/darts-dice-coins/
+	total := sumWeights(weights, name)
+	n := len(weights)
+	t := &aliasTable{
+		weights: weights,
+		prob:    make([]float64, n),
+		alias:   make([]int, n),
+	}
+	scale := float64(n) / total
+	var small, large []int
+	for i, w := range weights {
+		t.prob[i] = w * scale
+		if t.prob[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+		t.alias[s] = l
+		t.prob[l] = (t.prob[l] + t.prob[s]) - 1
+		if t.prob[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	// Anything left over is due to rounding error and should be certain.
+	for _, i := range large {
+		t.prob[i] = 1
+	}
+	for _, i := range small {
+		t.prob[i] = 1
+	}
+	return t
+}
+
+func (t *aliasTable) pick() int {
+	i := intn(len(t.prob))
+	if Float64() < t.prob[i] {
+		return i
+	}
+	return t.alias[i]
+}
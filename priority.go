@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "container/heap"
+
+// A PrioritySample keeps a priority sample of at most k weighted elements
+// from a stream of unknown length. The estimated weights of the sampled
+// elements give unbiased estimates of the total weight of any subset
+// of the stream.
+//
+// See "Priority Sampling for Estimation of Arbitrary Subset Sums"
+// (Duffield, Lund & Thorup, 2007).
+//
+// A PrioritySample is not safe for concurrent use.
+type PrioritySample[E any] struct {
+	k int
+	h reservoirHeap[PriorityItem[E]] // k+1 highest priorities
+}
+
+// A PriorityItem is an element of a PrioritySample.
+type PriorityItem[E any] struct {
+	// Value is the offered element.
+	Value E
+	// Weight is the offered weight.
+	Weight float64
+	// Estimate is the element's weight adjusted for the sampling,
+	// such that the sum of estimates over any subset of the sample
+	// is an unbiased estimate of the weight of that subset in the stream.
+	Estimate float64
+}
+
+// NewPrioritySample returns a PrioritySample that holds at most k elements.
+// It panics if k <= 0.
+func NewPrioritySample[E any](k int) *PrioritySample[E] {
+	if k <= 0 {
+		panic("fastrand.NewPrioritySample: invalid argument")
+	}
+	return &PrioritySample[E]{
+		k: k,
+		h: make(reservoirHeap[PriorityItem[E]], 0, k+1),
+	}
+}
+
+// Offer offers e with the given weight to the sample.
+// Elements with zero weight are never sampled.
+// It panics if weight is negative or NaN.
+func (s *PrioritySample[E]) Offer(e E, weight float64) {
+	if !(weight >= 0) {
+		panic("fastrand.PrioritySample.Offer: invalid weight")
+	}
+	if weight == 0 {
+		return
+	}
+	item := reservoirItem[PriorityItem[E]]{
+		key: weight / unitOpenZero(),
+		val: PriorityItem[E]{Value: e, Weight: weight},
+	}
+	if len(s.h) <= s.k {
+		heap.Push(&s.h, item)
+		return
+	}
+	if item.key > s.h[0].key {
+		s.h[0] = item
+		heap.Fix(&s.h, 0)
+	}
+}
+
+// Len returns the number of elements in the sample.
+func (s *PrioritySample[E]) Len() int {
+	return min(len(s.h), s.k)
+}
+
+// Sample returns the elements in the sample in no particular order.
+func (s *PrioritySample[E]) Sample() []PriorityItem[E] {
+	if len(s.h) <= s.k {
+		// Every element has been kept, so the estimates are exact.
+		items := make([]PriorityItem[E], len(s.h))
+		for i, item := range s.h {
+			items[i] = item.val
+			items[i].Estimate = item.val.Weight
+		}
+		return items
+	}
+	// The threshold is the (k+1)th highest priority, which is at the root.
+	tau := s.h[0].key
+	items := make([]PriorityItem[E], 0, s.k)
+	for _, item := range s.h[1:] {
+		v := item.val
+		v.Estimate = max(v.Weight, tau)
+		items = append(items, v)
+	}
+	return items
+}
+
+// Reset empties the sample.
+func (s *PrioritySample[E]) Reset() {
+	clear(s.h)
+	s.h = s.h[:0]
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package quickrand provides testing/quick configurations backed by fastrand.
+package quickrand
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"bursavich.dev/fastrand"
+)
+
+// Values returns a function that populates the arguments of f with
+// pseudo-random values generated by fastrand.FillValue.
+// It is suitable for use as quick.Config.Values.
+// It panics if f is not a function.
+func Values(f any) func(args []reflect.Value, r *rand.Rand) {
+	t := reflect.TypeOf(f)
+	if t == nil || t.Kind() != reflect.Func {
+		panic("quickrand.Values: non-function argument")
+	}
+	return func(args []reflect.Value, _ *rand.Rand) {
+		for i := range args {
+			p := reflect.New(t.In(i))
+			fastrand.FillValue(p.Interface())
+			args[i] = p.Elem()
+		}
+	}
+}
+
+// Config returns a quick.Config for checking f that runs maxCount iterations,
+// or the quick package's default if maxCount is zero, with arguments generated
+// by Values. The arguments can't be reproduced; use SeededConfig for that.
+// It panics if f is not a function.
+func Config(f any, maxCount int) *quick.Config {
+	return &quick.Config{
+		MaxCount: maxCount,
+		Values:   Values(f),
+	}
+}
+
+// SeededConfig returns a quick.Config that runs maxCount iterations, or the
+// quick package's default if maxCount is zero, with arguments generated
+// from a math/rand source with the given seed, so that a failure can be
+// reproduced by passing the same seed. If seed is zero, a pseudo-random seed
+// is chosen. The seed is logged to tb if the test fails.
+func SeededConfig(tb testing.TB, maxCount int, seed int64) *quick.Config {
+	tb.Helper()
+	for seed == 0 {
+		seed = fastrand.Int63()
+	}
+	tb.Cleanup(func() {
+		if tb.Failed() {
+			tb.Logf("quickrand: seed %d", seed)
+		}
+	})
+	return &quick.Config{
+		MaxCount: maxCount,
+		Rand:     rand.New(rand.NewSource(seed)),
+	}
+}
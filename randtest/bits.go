@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package randtest
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+)
+
+// bitWords is the number of 64-bit words drawn by the bit tests.
+const bitWords = 1 << 14
+
+// Monobit draws 2^20 bits and fails the test if the frequency (monobit) test
+// of NIST SP 800-22 §2.1 rejects the hypothesis that ones and zeros are
+// equally likely.
+func Monobit(tb testing.TB, draw func() uint64) {
+	tb.Helper()
+	const n = bitWords * 64
+	var ones int
+	for i := 0; i < bitWords; i++ {
+		ones += bits.OnesCount64(draw())
+	}
+	if p := monobitP(ones, n); p < alpha {
+		tb.Errorf("randtest.Monobit: %d ones in %d bits (p = %.3g)", ones, n, p)
+	}
+}
+
+// Runs draws 2^20 bits and fails the test if the runs test of NIST SP 800-22
+// §2.3 rejects the hypothesis that the number of uninterrupted sequences of
+// identical bits is what would be expected of independent bits. The bits of
+// each value are taken from least to most significant.
+func Runs(tb testing.TB, draw func() uint64) {
+	tb.Helper()
+	const n = bitWords * 64
+	var ones int
+	var prev uint64 // most significant bit of the previous value
+	runs := 1
+	for i := 0; i < bitWords; i++ {
+		w := draw()
+		ones += bits.OnesCount64(w)
+		runs += bits.OnesCount64((w ^ w>>1) &^ (1 << 63))
+		if i > 0 && (w^prev)&1 != 0 {
+			runs++
+		}
+		prev = w >> 63
+	}
+	if p := monobitP(ones, n); p < alpha {
+		// The runs test isn't applicable if the monobit test fails.
+		tb.Errorf("randtest.Runs: %d ones in %d bits (p = %.3g)", ones, n, p)
+		return
+	}
+	pi := float64(ones) / n
+	q := 2 * n * pi * (1 - pi)
+	s := math.Abs(float64(runs)-q) / (2 * math.Sqrt(2*n) * pi * (1 - pi))
+	if p := math.Erfc(s); p < alpha {
+		tb.Errorf("randtest.Runs: %d runs in %d bits (p = %.3g)", runs, n, p)
+	}
+}
+
+func monobitP(ones, n int) float64 {
+	s := math.Abs(float64(2*ones-n)) / math.Sqrt(float64(n))
+	return math.Erfc(s / math.Sqrt2)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package randtest
+
+import (
+	"math/rand/v2"
+	"sync/atomic"
+)
+
+// HistogramBuckets is the number of buckets in an InstrumentedSource's histogram.
+const HistogramBuckets = 16
+
+// An InstrumentedSource is a math/rand/v2 source that counts the values drawn
+// from an underlying source, to debug skewed draws. Its counters are safe
+// for concurrent use, even if the underlying source isn't.
+type InstrumentedSource struct {
+	src     rand.Source
+	calls   atomic.Int64
+	buckets [HistogramBuckets]atomic.Int64
+}
+
+var _ rand.Source = (*InstrumentedSource)(nil)
+
+// Instrument returns a source that counts the values drawn from src.
+func Instrument(src rand.Source) *InstrumentedSource {
+	return &InstrumentedSource{src: src}
+}
+
+// Uint64 returns a value from the underlying source.
+func (s *InstrumentedSource) Uint64() uint64 {
+	v := s.src.Uint64()
+	s.record(v)
+	return v
+}
+
+func (s *InstrumentedSource) record(v uint64) {
+	s.calls.Add(1)
+	s.buckets[v>>60].Add(1)
+}
+
+// Calls returns the number of values drawn.
+func (s *InstrumentedSource) Calls() int64 {
+	return s.calls.Load()
+}
+
+// Histogram returns the number of values drawn in each of HistogramBuckets
+// equal ranges of uint64, ordered from least to greatest.
+func (s *InstrumentedSource) Histogram() []int64 {
+	h := make([]int64, HistogramBuckets)
+	for i := range h {
+		h[i] = s.buckets[i].Load()
+	}
+	return h
+}
+
+// Reset sets the counters to zero.
+func (s *InstrumentedSource) Reset() {
+	s.calls.Store(0)
+	for i := range s.buckets {
+		s.buckets[i].Store(0)
+	}
+}
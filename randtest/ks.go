@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package randtest
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// KolmogorovSmirnov draws 10000 values and fails the test if a one-sample
+// Kolmogorov–Smirnov test rejects the hypothesis that they're distributed
+// according to the continuous cumulative distribution function cdf.
+// For example, to check a standard normal distribution:
+//
+//	randtest.KolmogorovSmirnov(t, fastrand.NormFloat64, func(x float64) float64 {
+//		return (1 + math.Erf(x/math.Sqrt2)) / 2
+//	})
+func KolmogorovSmirnov(tb testing.TB, draw func() float64, cdf func(float64) float64) {
+	tb.Helper()
+	const n = 10000
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = draw()
+	}
+	slices.Sort(xs)
+	var d float64
+	for i, x := range xs {
+		f := cdf(x)
+		d = max(d, f-float64(i)/n, float64(i+1)/n-f)
+	}
+	if p := kolmogorovSF(d, n); p < alpha {
+		tb.Errorf("randtest.KolmogorovSmirnov: statistic %.4f with %d samples (p = %.3g)", d, n, p)
+	}
+}
+
+// kolmogorovSF returns the asymptotic probability that the Kolmogorov–Smirnov
+// statistic of n samples exceeds d, following Numerical Recipes §14.3.
+func kolmogorovSF(d float64, n int) float64 {
+	sn := math.Sqrt(float64(n))
+	l := (sn + 0.12 + 0.11/sn) * d
+	if l < 0.2 {
+		return 1
+	}
+	var sum float64
+	sign := 2.0
+	for k := 1.0; k <= 100; k++ {
+		term := sign * math.Exp(-2*k*k*l*l)
+		sum += term
+		if math.Abs(term) < 1e-15*sum {
+			break
+		}
+		sign = -sign
+	}
+	return min(max(sum, 0), 1)
+}
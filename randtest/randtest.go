@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package randtest provides statistical tests of pseudo-random generators
+// for use in test suites.
+//
+// The tests are smoke tests, not a substitute for a statistical test suite
+// such as PractRand or TestU01. Each fails with probability alpha = 1e-6
+// when the generator is sound, so they're practically never flaky.
+package randtest
+
+import (
+	"math"
+	"testing"
+)
+
+// alpha is the significance level of the tests.
+const alpha = 1e-6
+
+// Uniform draws 1000 values per bucket, reduces each modulo buckets, and fails
+// the test if a chi-square goodness-of-fit test rejects the hypothesis that the
+// values are uniformly distributed among the buckets. The draw function should
+// return values uniformly distributed in the half-open interval [0,buckets)
+// or over the full range of uint64.
+// It panics if buckets < 2.
+func Uniform(tb testing.TB, draw func() uint64, buckets int) {
+	tb.Helper()
+	if buckets < 2 {
+		panic("randtest.Uniform: invalid argument")
+	}
+	const perBucket = 1000
+	counts := make([]int, buckets)
+	for i := 0; i < buckets*perBucket; i++ {
+		counts[draw()%uint64(buckets)]++
+	}
+	var stat float64
+	for _, c := range counts {
+		d := float64(c - perBucket)
+		stat += d * d / perBucket
+	}
+	if p := chiSquareSF(stat, float64(buckets-1)); p < alpha {
+		tb.Errorf("randtest.Uniform: chi-square statistic %.1f with %d degrees of freedom (p = %.3g)", stat, buckets-1, p)
+	}
+}
+
+// chiSquareSF returns the probability that a chi-square distribution
+// with k degrees of freedom exceeds x.
+func chiSquareSF(x, k float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return gammaQ(k/2, x/2)
+}
+
+// gammaQ returns the regularized upper incomplete gamma function Q(a,x),
+// following Numerical Recipes §6.2.
+func gammaQ(a, x float64) float64 {
+	lg, _ := math.Lgamma(a)
+	prefix := math.Exp(a*math.Log(x) - x - lg)
+	if x < a+1 {
+		// Series representation of P(a,x).
+		sum, term := 1/a, 1/a
+		for n := 1.0; n < 1000; n++ {
+			term *= x / (a + n)
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return 1 - sum*prefix
+	}
+	// Continued fraction representation of Q(a,x) by the modified Lentz method.
+	const tiny = 1e-300
+	b := x + 1 - a
+	c, d := 1/tiny, 1/b
+	h := d
+	for i := 1.0; i < 1000; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return h * prefix
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package randtest
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// A ScriptSource is a math/rand/v2 source that returns a fixed sequence of
+// values, so that tests of code that accepts a *rand.Rand can pin exact
+// randomness.
+type ScriptSource struct {
+	values []uint64
+	next   int
+}
+
+var _ rand.Source = (*ScriptSource)(nil)
+
+// Script returns a source that returns the given values in order,
+// repeating them when they're exhausted.
+//
+// Scripts can force worst-case draws. For example, Script(0) makes Float64
+// of a *rand.Rand return 0, and Script(math.MaxUint64) makes IntN(n) return
+// n-1. Beware that some methods reject certain values and retry, so a
+// script that returns only rejected values loops forever: IntN, Uint64N,
+// Shuffle, and the like reject 0 unless n is a power of two. Min, Max,
+// and Alternate force extremes without retries.
+//
+// It panics if no values are given.
+func Script(values ...uint64) *ScriptSource {
+	if len(values) == 0 {
+		panic("randtest.Script: no values")
+	}
+	return &ScriptSource{values: values}
+}
+
+// Uint64 returns the next value.
+func (s *ScriptSource) Uint64() uint64 {
+	v := s.values[s.next]
+	s.next = (s.next + 1) % len(s.values)
+	return v
+}
+
+// Reset restarts the sequence from the beginning.
+func (s *ScriptSource) Reset() {
+	s.next = 0
+}
+
+// Min returns a source that forces a math/rand/v2 generator to draw the
+// minimum values its methods accept without retrying: IntN, Uint64N,
+// and the like return 0 for any n less than 2³², Shuffle swaps each
+// element with the first, and Float64 returns 2⁻²¹.
+func Min() *ScriptSource {
+	// The low bits are zero for methods that mask powers of two,
+	// and the high bits are zero for methods that multiply, which
+	// reject zero itself.
+	return Script(1 << 32)
+}
+
+// Max returns a source that forces a math/rand/v2 generator to draw the
+// maximum values its methods accept without retrying: IntN, Uint64N,
+// and the like return n-1, Shuffle swaps each element with itself,
+// and Float64 returns the largest float64 less than 1.
+func Max() *ScriptSource {
+	return Script(math.MaxUint64)
+}
+
+// Alternate returns a source that forces a math/rand/v2 generator to
+// alternate between the draws forced by Max and Min, starting with Max.
+func Alternate() *ScriptSource {
+	return Script(math.MaxUint64, 1<<32)
+}
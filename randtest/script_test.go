@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package randtest
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+var bounds = []int{1, 2, 3, 8, 10, 1000, 1 << 20, 1<<31 - 1, 1<<32 - 1}
+
+func TestMin(t *testing.T) {
+	r := rand.New(Min())
+	for _, n := range bounds {
+		if v := r.IntN(n); v != 0 {
+			t.Errorf("IntN(%d) = %d; want 0", n, v)
+		}
+	}
+	if v := r.Float64(); v != 0x1p-21 {
+		t.Errorf("Float64() = %v; want %v", v, 0x1p-21)
+	}
+	r.Shuffle(100, func(i, j int) {
+		if j != 0 {
+			t.Errorf("Shuffle swapped %d with %d; want 0", i, j)
+		}
+	})
+}
+
+func TestMax(t *testing.T) {
+	r := rand.New(Max())
+	for _, n := range bounds {
+		if v := r.IntN(n); v != n-1 {
+			t.Errorf("IntN(%d) = %d; want %d", n, v, n-1)
+		}
+	}
+	if v, want := r.Float64(), math.Nextafter(1, 0); v != want {
+		t.Errorf("Float64() = %v; want %v", v, want)
+	}
+	r.Shuffle(100, func(i, j int) {
+		if j != i {
+			t.Errorf("Shuffle swapped %d with %d; want %d", i, j, i)
+		}
+	})
+}
+
+func TestAlternate(t *testing.T) {
+	r := rand.New(Alternate())
+	for _, n := range bounds {
+		if v := r.IntN(n); v != n-1 {
+			t.Errorf("IntN(%d) = %d; want %d", n, v, n-1)
+		}
+		if v := r.IntN(n); v != 0 {
+			t.Errorf("IntN(%d) = %d; want 0", n, v)
+		}
+	}
+	if v, want := r.Float64(), math.Nextafter(1, 0); v != want {
+		t.Errorf("Float64() = %v; want %v", v, want)
+	}
+	if v := r.Float64(); v != 0x1p-21 {
+		t.Errorf("Float64() = %v; want %v", v, 0x1p-21)
+	}
+	var k int
+	r.Shuffle(100, func(i, j int) {
+		if want := []int{i, 0}[k%2]; j != want {
+			t.Errorf("Shuffle swapped %d with %d; want %d", i, j, want)
+		}
+		k++
+	})
+}
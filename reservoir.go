@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"container/heap"
+	"math"
+)
+
+// A WeightedReservoir keeps a weighted pseudo-random sample of at most k elements
+// from a stream of unknown length. The probability of an element being in the sample
+// is proportional to its weight.
+//
+// A WeightedReservoir is not safe for concurrent use.
+type WeightedReservoir[E any] struct {
+	k    int
+	h    reservoirHeap[E]
+	skip float64 // remaining weight to skip before the next insertion
+}
+
+// NewWeightedReservoir returns a WeightedReservoir that holds at most k elements.
+// It panics if k <= 0.
+func NewWeightedReservoir[E any](k int) *WeightedReservoir[E] {
+	if k <= 0 {
+		panic("fastrand.NewWeightedReservoir: invalid argument")
+	}
+	return &WeightedReservoir[E]{
+		k: k,
+		h: make(reservoirHeap[E], 0, k),
+	}
+}
+
+// Offer offers e with the given weight to the reservoir.
+// Elements with zero weight are never sampled.
+// It panics if weight is negative or NaN.
+func (r *WeightedReservoir[E]) Offer(e E, weight float64) {
+	if !(weight >= 0) {
+		panic("fastrand.WeightedReservoir.Offer: invalid weight")
+	}
+	if weight == 0 {
+		return
+	}
+	// Algorithm A-Res with exponential jumps (A-ExpJ):
+	// Efraimidis and Spirakis, "Weighted random sampling with a reservoir" (2006).
+	//
+	// Each element is given the key u^(1/w) and the k largest keys are kept.
+	// Keys are stored as log(u)/w to preserve precision for small weights.
+	if len(r.h) < r.k {
+		heap.Push(&r.h, reservoirItem[E]{key: math.Log(unitOpenZero()) / weight, val: e})
+		if len(r.h) == r.k {
+			r.jump()
+		}
+		return
+	}
+	r.skip -= weight
+	if r.skip > 0 {
+		return
+	}
+	// The new key is drawn uniformly from (t^w, 1) where t is the smallest key.
+	tw := math.Exp(r.h[0].key * weight)
+	u := tw + (1-tw)*unitOpenZero()
+	r.h[0] = reservoirItem[E]{key: math.Log(u) / weight, val: e}
+	heap.Fix(&r.h, 0)
+	r.jump()
+}
+
+// jump draws the amount of weight to skip before the next insertion.
+func (r *WeightedReservoir[E]) jump() {
+	// X = log(u) / log(t) where t is the smallest key.
+	r.skip = math.Log(unitOpenZero()) / r.h[0].key
+}
+
+// Len returns the number of elements in the sample.
+func (r *WeightedReservoir[E]) Len() int {
+	return len(r.h)
+}
+
+// Sample returns the elements in the sample in no particular order.
+func (r *WeightedReservoir[E]) Sample() []E {
+	s := make([]E, len(r.h))
+	for i, item := range r.h {
+		s[i] = item.val
+	}
+	return s
+}
+
+// Reset empties the reservoir.
+func (r *WeightedReservoir[E]) Reset() {
+	clear(r.h)
+	r.h = r.h[:0]
+	r.skip = 0
+}
+
+// unitOpenZero returns a pseudo-random float64 in the half-open interval (0,1].
+func unitOpenZero() float64 {
+	return 1 - Float64()
+}
+
+type reservoirItem[E any] struct {
+	key float64
+	val E
+}
+
+// reservoirHeap is a min-heap of items ordered by key.
+type reservoirHeap[E any] []reservoirItem[E]
+
+func (h reservoirHeap[E]) Len() int           { return len(h) }
+func (h reservoirHeap[E]) Less(i, j int) bool { return h[i].key < h[j].key }
+func (h reservoirHeap[E]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *reservoirHeap[E]) Push(x any) {
+	*h = append(*h, x.(reservoirItem[E]))
+}
+
+func (h *reservoirHeap[E]) Pop() any {
+	old := *h
+	n := len(old) - 1
+	x := old[n]
+	old[n] = reservoirItem[E]{}
+	*h = old[:n]
+	return x
+}
@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"cmp"
+	"hash/fnv"
+	"math"
+	"slices"
+)
+
+// Subset returns a new slice containing each element of s independently
+// with probability p. The relative order of the elements is preserved.
+// It panics if p is not in the closed interval [0,1].
+func Subset[E any](s []E, p float64) []E {
+	if !(p >= 0 && p <= 1) {
+		panic("fastrand.Subset: invalid probability")
+	}
+	var sub []E
+	for _, e := range s {
+		if Float64() < p {
+			sub = append(sub, e)
+		}
+	}
+	return sub
+}
+
+// SubsetMask returns a mask of length n in which each element
+// is independently true with probability p.
+// It panics if n < 0 or if p is not in the closed interval [0,1].
+func SubsetMask(n int, p float64) []bool {
+	if n < 0 {
+		panic("fastrand.SubsetMask: invalid length")
+	}
+	if !(p >= 0 && p <= 1) {
+		panic("fastrand.SubsetMask: invalid probability")
+	}
+	mask := make([]bool, n)
+	for i := range mask {
+		mask[i] = Float64() < p
+	}
+	return mask
+}
+
+// Combination returns k distinct integers in ascending order chosen
+// pseudo-randomly from the half-open interval [0,n). Each of the
+// C(n,k) possible combinations is equally likely.
+// It panics if k < 0 or k > n.
+func Combination(n, k int) []int {
+	if k < 0 || k > n {
+		panic("fastrand.Combination: invalid argument")
+	}
+	// Floyd's algorithm: https://doi.org/10.1145/30401.315746
+	set := make(map[int]struct{}, k)
+	c := make([]int, 0, k)
+	for j := n - k; j < n; j++ {
+		v := intn(j + 1)
+		if _, ok := set[v]; ok {
+			v = j
+		}
+		set[v] = struct{}{}
+		c = append(c, v)
+	}
+	slices.Sort(c)
+	return c
+}
+
+// Composition returns parts non-negative integers that sum to total.
+// Each of the possible ordered compositions is equally likely.
+// It panics if total < 0 or parts <= 0.
+func Composition(total, parts int) []int {
+	if total < 0 || parts <= 0 {
+		panic("fastrand.Composition: invalid argument")
+	}
+	// Stars and bars: choose the positions of parts-1 bars among
+	// total+parts-1 slots, the remaining slots are stars.
+	bars := Combination(total+parts-1, parts-1)
+	c := make([]int, parts)
+	prev := -1
+	for i, b := range bars {
+		c[i] = b - prev - 1
+		prev = b
+	}
+	c[parts-1] = total + parts - 1 - prev - 1
+	return c
+}
+
+// CyclePerm returns a pseudo-random permutation of the integers in the
+// half-open interval [0,n) that consists of a single cycle: starting at any
+// index i and repeatedly following p[i] visits every index before returning to i.
+// Each of the (n-1)! cyclic permutations is equally likely.
+// It panics if n < 0.
+func CyclePerm(n int) []int {
+	if n < 0 {
+		panic("fastrand.CyclePerm: invalid argument")
+	}
+	p := make([]int, n)
+	for i := range p {
+		p[i] = i
+	}
+	// Sattolo's algorithm: https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle#Sattolo's_algorithm
+	for i := n - 1; i > 0; i-- {
+		j := intn(i)
+		p[i], p[j] = p[j], p[i]
+	}
+	return p
+}
+
+// TwoChoices returns two distinct pseudo-random integers in the half-open interval [0,n),
+// as used by the "power of two choices" load balancing strategy.
+// It panics if n < 2.
+func TwoChoices(n int) (i, j int) {
+	if n < 2 {
+		panic("fastrand.TwoChoices: invalid argument")
+	}
+	i = intn(n)
+	j = intn(n - 1)
+	if j >= i {
+		j++
+	}
+	return i, j
+}
+
+// PickTwo returns two pseudo-random elements of s at distinct indices.
+// It panics if len(s) < 2.
+func PickTwo[E any](s []E) (E, E) {
+	if len(s) < 2 {
+		panic("fastrand.PickTwo: invalid argument")
+	}
+	i, j := TwoChoices(len(s))
+	return s[i], s[j]
+}
+
+// SampleKey reports whether key is sampled with the given probability.
+// Unlike other functions in this package, the result is deterministic:
+// the same key and probability always produce the same decision,
+// across processes and machines, so related decisions keyed by a
+// shared ID (e.g. all spans in a trace) are made consistently.
+// A key sampled at one probability is also sampled at any higher probability.
+// It panics if probability is not in the closed interval [0,1].
+func SampleKey(key []byte, probability float64) bool {
+	if !(probability >= 0 && probability <= 1) {
+		panic("fastrand.SampleKey: invalid probability")
+	}
+	h := fnv.New64a()
+	h.Write(key)
+	// Compare the top 53 bits of the mixed hash to the threshold,
+	// so that the threshold is exactly representable as a float64.
+	const mult = 1 << 53
+	return float64(mix64(h.Sum64())>>11) < probability*mult
+}
+
+// mix64 is the SplitMix64 finalizer, which improves the avalanche of
+// the FNV hash so that its high bits are well distributed.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Sample returns k pseudo-random elements of s chosen without replacement,
+// so no index of s is chosen more than once. The elements are returned in
+// pseudo-random order.
+// It panics if k < 0 or k > len(s).
+func Sample[E any](s []E, k int) []E {
+	if k < 0 || k > len(s) {
+		panic("fastrand.Sample: invalid argument")
+	}
+	// Partial Fisher-Yates shuffle of the indices of s,
+	// sparsely represented by only the indices that have moved.
+	moved := make(map[int]int, k)
+	sample := make([]E, k)
+	for i := range sample {
+		j := i + intn(len(s)-i)
+		vj, ok := moved[j]
+		if !ok {
+			vj = j
+		}
+		vi, ok := moved[i]
+		if !ok {
+			vi = i
+		}
+		moved[j] = vi
+		sample[i] = s[vj]
+	}
+	return sample
+}
+
+// SampleReplace returns k pseudo-random elements of s chosen with replacement,
+// so any index of s may be chosen more than once.
+// It panics if k < 0 or if k > 0 and s is empty.
+func SampleReplace[E any](s []E, k int) []E {
+	if k < 0 || (k > 0 && len(s) == 0) {
+		panic("fastrand.SampleReplace: invalid argument")
+	}
+	sample := make([]E, k)
+	for i := range sample {
+		sample[i] = s[intn(len(s))]
+	}
+	return sample
+}
+
+// Split partitions a pseudo-random selection of the integers in the half-open
+// interval [0,n) into disjoint groups, each in ascending order, with sizes
+// proportional to fractions. For example, Split(n, 0.8, 0.1, 0.1) returns
+// training, validation, and test sets. The sizes are exact: they're rounded
+// to sum to the fraction of n selected, so that if the fractions sum to one,
+// every integer is in some group.
+//
+// To reproduce a split, such as for a fixed evaluation set, build with the
+// deterministic tag and set FASTRAND_SEED; see the package documentation.
+//
+// It panics if n < 0, if any fraction is negative or NaN,
+// or if the fractions sum to more than one.
+func Split(n int, fractions ...float64) [][]int {
+	if n < 0 {
+		panic("fastrand.Split: invalid argument")
+	}
+	var sum float64
+	for _, f := range fractions {
+		if !(f >= 0) {
+			panic("fastrand.Split: invalid fraction")
+		}
+		sum += f
+	}
+	if sum > 1+1e-9 {
+		panic("fastrand.Split: fractions sum to more than one")
+	}
+	// Fractions that sum to slightly more than one due to rounding
+	// are scaled down so that the sizes never exceed n.
+	scale := 1.0
+	if sum > 1 {
+		scale, sum = 1/sum, 1
+	}
+	// Apportion the selected integers by the largest remainder method.
+	total := min(int(math.Round(sum*float64(n))), n)
+	sizes := make([]int, len(fractions))
+	order := make([]int, len(fractions))
+	rem := make([]float64, len(fractions))
+	assigned := 0
+	for i, f := range fractions {
+		exact := f * scale * float64(n)
+		sizes[i] = int(exact)
+		rem[i] = exact - float64(sizes[i])
+		order[i] = i
+		assigned += sizes[i]
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(rem[b], rem[a]) })
+	for i := 0; assigned < total; i++ {
+		sizes[order[i%len(order)]]++
+		assigned++
+	}
+	// Select the integers by a partial Fisher-Yates shuffle.
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	for i := 0; i < total; i++ {
+		j := i + intn(n-i)
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+	groups := make([][]int, len(fractions))
+	for i, size := range sizes {
+		groups[i] = perm[:size:size]
+		perm = perm[size:]
+		slices.Sort(groups[i])
+	}
+	return groups
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// A Sampler decides whether to sample events, such as log lines or traces,
+// each independently with a fixed probability. It holds no mutable state,
+// so it's safe for concurrent use without contention on hot paths.
+// The zero value samples nothing.
+type Sampler struct {
+	threshold uint64 // sample if 53 random bits are less than this
+}
+
+// NewSampler returns a Sampler that samples events with probability p.
+// It panics if p is not in the closed interval [0,1].
+func NewSampler(p float64) Sampler {
+	if !(p >= 0 && p <= 1) {
+		panic("fastrand.NewSampler: invalid probability")
+	}
+	return Sampler{threshold: uint64(math.Round(p * (1 << 53)))}
+}
+
+// NewEveryN returns a Sampler that samples on average one of every n events.
+// Rather than counting events, which would contend on a shared counter,
+// it samples each event independently with probability 1/n, so the intervals
+// between samples are jittered.
+// It panics if n < 1.
+func NewEveryN(n int) Sampler {
+	if n < 1 {
+		panic("fastrand.NewEveryN: invalid argument")
+	}
+	return NewSampler(1 / float64(n))
+}
+
+// Sample reports whether to sample an event.
+func (s Sampler) Sample() bool {
+	return u64()>>11 < s.threshold
+}
+
+// Probability returns the probability that an event is sampled.
+func (s Sampler) Probability() float64 {
+	return float64(s.threshold) / (1 << 53)
+}
+
+// An ExpSampler samples on average one of every mean bytes or events,
+// like the runtime's memory profiler. Rather than deciding for each event,
+// a caller draws the number of events to skip until the next sample and
+// counts it down, which is cheaper when events are frequent and allows
+// sampling by size, such as bytes allocated or written. It holds no mutable
+// state, so it's safe for concurrent use; each caller keeps its own count.
+// The zero value samples every event.
+type ExpSampler struct {
+	logq float64 // log(1 - 1/mean)
+}
+
+// NewExpSampler returns an ExpSampler that samples on average one of every
+// mean bytes or events. It panics if mean < 1 or mean is infinite.
+func NewExpSampler(mean float64) ExpSampler {
+	if !(mean >= 1) || math.IsInf(mean, 1) {
+		panic("fastrand.NewExpSampler: invalid argument")
+	}
+	return ExpSampler{logq: math.Log1p(-1 / mean)}
+}
+
+// Skip returns the pseudo-random number of bytes or events until the next
+// sample, counting the sampled one, so it's always at least 1. The counts are
+// exponentially distributed, rounded up to the next integer so that their mean
+// is exactly the sampler's mean and sampling is memoryless: whether a byte is
+// sampled doesn't depend on how many preceded it. For example:
+//
+//	next -= int64(len(p))
+//	if next <= 0 {
+//		record(p)
+//		next = s.Skip()
+//	}
+func (s ExpSampler) Skip() int64 {
+	if s.logq == 0 {
+		return 1
+	}
+	n := math.Floor(math.Log(unitOpenZero())/s.logq) + 1
+	if n >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(n)
+}
+
+// Mean returns the mean number of bytes or events between samples.
+func (s ExpSampler) Mean() float64 {
+	if s.logq == 0 {
+		return 1
+	}
+	return 1 / -math.Expm1(s.logq)
+}
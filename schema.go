@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
+
+// A FieldKind is the kind of value generated for a RecordField.
+type FieldKind int
+
+const (
+	// FieldInt is an int64 in the closed interval [Min,Max].
+	FieldInt FieldKind = iota
+	// FieldFloat is a float64 in the half-open interval [Min,Max).
+	FieldFloat
+	// FieldString is a string of MinLen to MaxLen characters from Alphabet,
+	// or from [A-Za-z0-9] if Alphabet is empty.
+	FieldString
+	// FieldBool is a bool that is true with probability 0.5.
+	FieldBool
+	// FieldEnum is a string chosen uniformly from Enum.
+	FieldEnum
+	// FieldUUID is a version 4 UUID string.
+	FieldUUID
+	// FieldTime is a time.Time in the half-open interval [MinTime,MaxTime),
+	// which must be no longer than the maximum time.Duration.
+	FieldTime
+)
+
+// A RecordField describes a field of the records generated by a RecordSchema.
+type RecordField struct {
+	// Name is the field's key in generated records.
+	Name string
+	// Kind is the kind of value generated.
+	Kind FieldKind
+	// Min and Max bound FieldInt and FieldFloat values.
+	Min, Max float64
+	// MinLen and MaxLen bound the length of FieldString values.
+	MinLen, MaxLen int
+	// Alphabet is the set of characters of FieldString values.
+	Alphabet string
+	// Enum is the set of FieldEnum values.
+	Enum []string
+	// MinTime and MaxTime bound FieldTime values.
+	MinTime, MaxTime time.Time
+	// NullProbability is the probability that the value is nil instead.
+	NullProbability float64
+}
+
+// A RecordSchema describes the fields of pseudo-random records.
+type RecordSchema []RecordField
+
+// Record returns a pseudo-random record conforming to the schema.
+// It panics if any field of the schema is invalid.
+func (s RecordSchema) Record() map[string]any {
+	r := make(map[string]any, len(s))
+	for i := range s {
+		f := &s[i]
+		r[f.Name] = f.value()
+	}
+	return r
+}
+
+// Records returns n pseudo-random records conforming to the schema.
+// It panics if n < 0 or if any field of the schema is invalid.
+func (s RecordSchema) Records(n int) []map[string]any {
+	if n < 0 {
+		panic("fastrand.RecordSchema.Records: invalid argument")
+	}
+	rs := make([]map[string]any, n)
+	for i := range rs {
+		rs[i] = s.Record()
+	}
+	return rs
+}
+
+// JSON returns the JSON encoding of a pseudo-random record conforming to the schema.
+// It panics if any field of the schema is invalid.
+func (s RecordSchema) JSON() []byte {
+	b, err := json.Marshal(s.Record())
+	if err != nil {
+		panic("fastrand.RecordSchema.JSON: " + err.Error())
+	}
+	return b
+}
+
+func (f *RecordField) value() any {
+	if !(f.NullProbability >= 0 && f.NullProbability <= 1) {
+		f.invalid()
+	}
+	if Float64() < f.NullProbability {
+		return nil
+	}
+	switch f.Kind {
+	case FieldInt:
+		lo, hi := math.Ceil(f.Min), math.Floor(f.Max)
+		if !(lo <= hi) || lo < math.MinInt64 || hi >= math.MaxInt64 {
+			f.invalid()
+		}
+		return int64Between(int64(lo), int64(hi))
+	case FieldFloat:
+		if !(f.Min < f.Max) || math.IsInf(f.Max-f.Min, 0) {
+			f.invalid()
+		}
+		v := f.Min + Float64()*(f.Max-f.Min)
+		if v >= f.Max {
+			// Rounding may reach Max in a narrow interval.
+			v = math.Nextafter(f.Max, f.Min)
+		}
+		return v
+	case FieldString:
+		if f.MinLen < 0 || f.MinLen > f.MaxLen {
+			f.invalid()
+		}
+		n := f.MinLen + intn(f.MaxLen-f.MinLen+1)
+		if f.Alphabet == "" {
+			return AlphaNum(n)
+		}
+		return String(n, f.Alphabet)
+	case FieldBool:
+		return u32()&1 == 0
+	case FieldEnum:
+		if len(f.Enum) == 0 {
+			f.invalid()
+		}
+		return Pick(f.Enum)
+	case FieldUUID:
+		return UUIDv4String()
+	case FieldTime:
+		// Sub saturates if the interval is longer than about 292 years.
+		d := f.MaxTime.Sub(f.MinTime)
+		if d <= 0 || !f.MinTime.Add(d).Equal(f.MaxTime) {
+			f.invalid()
+		}
+		return f.MinTime.Add(time.Duration(Int63n(int64(d))))
+	default:
+		f.invalid()
+		return nil
+	}
+}
+
+func (f *RecordField) invalid() {
+	panic("fastrand.RecordSchema: invalid field: " + f.Name)
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+// Package sim supports deterministic simulation testing of code that depends
+// on time and randomness, by bundling a seeded generator with a fake clock.
+//
+// Package fastrand can't be seeded, so code under simulation should take
+// its randomness and time from a Sim rather than from fastrand and package time.
+package sim
+
+import (
+	"container/heap"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// Epoch is the initial time of a Sim's clock.
+var Epoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// A Sim is a seeded pseudo-random generator and a fake clock that advances
+// only when told to. Its methods are safe for concurrent use, but values are
+// reproducible only if they're drawn in the same order.
+type Sim struct {
+	mu     sync.Mutex
+	rand   *rand.Rand
+	now    time.Time
+	timers timerHeap
+	seq    uint64 // orders timers with equal deadlines
+}
+
+// New returns a simulation whose values are determined by seed
+// and whose clock starts at Epoch.
+func New(seed uint64) *Sim {
+	return &Sim{
+		rand: rand.New(rand.NewPCG(seed, seed)),
+		now:  Epoch,
+	}
+}
+
+// Uint64 returns a pseudo-random uint64.
+func (s *Sim) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rand.Uint64()
+}
+
+// Int64N returns a pseudo-random int64 in the half-open interval [0,n).
+// It panics if n <= 0.
+func (s *Sim) Int64N(n int64) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rand.Int64N(n)
+}
+
+// Float64 returns a pseudo-random float64 in the half-open interval [0,1).
+func (s *Sim) Float64() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rand.Float64()
+}
+
+// Jitter returns a pseudo-random duration in the interval [d - factor*d, d + factor*d],
+// like fastrand.Jitter.
+func (s *Sim) Jitter(d time.Duration, factor float64) time.Duration {
+	return time.Duration(float64(d) * (1 + factor*(2*s.Float64()-1)))
+}
+
+// Backoff returns a pseudo-random delay before retry attempt n, counting
+// from zero, using exponential backoff with full jitter: the delay is
+// uniform in the half-open interval [0, min(base*2^n, limit)).
+// It panics if n < 0, base <= 0, or limit < base.
+func (s *Sim) Backoff(n int, base, limit time.Duration) time.Duration {
+	if n < 0 || base <= 0 || limit < base {
+		panic("sim.Sim.Backoff: invalid argument")
+	}
+	d := limit
+	if n < 63 && base <= limit>>n {
+		d = base << n
+	}
+	return time.Duration(s.Int64N(int64(d)))
+}
+
+// Now returns the current time of the clock.
+func (s *Sim) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// Since returns the time elapsed on the clock since t.
+func (s *Sim) Since(t time.Time) time.Duration {
+	return s.Now().Sub(t)
+}
+
+// After returns a channel that receives the clock's time once
+// it's advanced by at least d.
+func (s *Sim) After(d time.Duration) <-chan time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- s.now
+		return ch
+	}
+	s.seq++
+	heap.Push(&s.timers, &timer{when: s.now.Add(d), seq: s.seq, ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward by d, firing due timers in order
+// of their deadlines. It panics if d is negative.
+func (s *Sim) Advance(d time.Duration) {
+	if d < 0 {
+		panic("sim.Sim.Advance: negative duration")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advanceTo(s.now.Add(d))
+}
+
+// AdvanceToNext moves the clock forward to the deadline of the next timer
+// and fires it, along with any others due at the same time. It reports
+// whether there was a timer.
+func (s *Sim) AdvanceToNext() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.timers) == 0 {
+		return false
+	}
+	s.advanceTo(s.timers[0].when)
+	return true
+}
+
+// advanceTo moves the clock forward to end, firing due timers in order
+// of their deadlines. The caller must hold s.mu.
+func (s *Sim) advanceTo(end time.Time) {
+	for len(s.timers) > 0 && !s.timers[0].when.After(end) {
+		t := heap.Pop(&s.timers).(*timer)
+		s.now = t.when
+		t.ch <- t.when
+	}
+	s.now = end
+}
+
+type timer struct {
+	when time.Time
+	seq  uint64
+	ch   chan time.Time
+}
+
+type timerHeap []*timer
+
+func (h timerHeap) Len() int { return len(h) }
+
+func (h timerHeap) Less(i, j int) bool {
+	if h[i].when.Equal(h[j].when) {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].when.Before(h[j].when)
+}
+
+func (h timerHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *timerHeap) Push(x any) { *h = append(*h, x.(*timer)) }
+
+func (h *timerHeap) Pop() any {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return t
+}
@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"math"
+	"math/bits"
+	"unicode/utf8"
+)
+
+// String returns a pseudo-random string of n characters drawn uniformly
+// from alphabet, which is interpreted as a sequence of UTF-8 encoded runes.
+// Repeated runes in alphabet are proportionally more likely to be drawn.
+// It panics if n < 0 or alphabet is empty.
+func String(n int, alphabet string) string {
+	if n < 0 || alphabet == "" {
+		panic("fastrand.String: invalid argument")
+	}
+	return bytesToString(appendString(make([]byte, 0, n), n, alphabet))
+}
+
+// AppendString appends n pseudo-random characters drawn uniformly from alphabet
+// to dst and returns the extended buffer. See String for details.
+// It panics if n < 0 or alphabet is empty.
+func AppendString(dst []byte, n int, alphabet string) []byte {
+	if n < 0 || alphabet == "" {
+		panic("fastrand.AppendString: invalid argument")
+	}
+	return appendString(dst, n, alphabet)
+}
+
+const (
+	upper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	lower  = "abcdefghijklmnopqrstuvwxyz"
+	digits = "0123456789"
+
+	alpha    = upper + lower
+	alphaNum = upper + lower + digits
+)
+
+// Alpha returns a pseudo-random string of n characters drawn uniformly from [A-Za-z].
+// It panics if n < 0.
+func Alpha(n int) string {
+	if n < 0 {
+		panic("fastrand.Alpha: invalid argument")
+	}
+	return bytesToString(appendASCII(make([]byte, 0, n), n, alpha))
+}
+
+// AppendAlpha appends n pseudo-random characters drawn uniformly from [A-Za-z]
+// to dst and returns the extended buffer.
+// It panics if n < 0.
+func AppendAlpha(dst []byte, n int) []byte {
+	if n < 0 {
+		panic("fastrand.AppendAlpha: invalid argument")
+	}
+	return appendASCII(dst, n, alpha)
+}
+
+// AlphaNum returns a pseudo-random string of n characters drawn uniformly from [A-Za-z0-9].
+// It panics if n < 0.
+func AlphaNum(n int) string {
+	if n < 0 {
+		panic("fastrand.AlphaNum: invalid argument")
+	}
+	return bytesToString(appendASCII(make([]byte, 0, n), n, alphaNum))
+}
+
+// AppendAlphaNum appends n pseudo-random characters drawn uniformly from [A-Za-z0-9]
+// to dst and returns the extended buffer.
+// It panics if n < 0.
+func AppendAlphaNum(dst []byte, n int) []byte {
+	if n < 0 {
+		panic("fastrand.AppendAlphaNum: invalid argument")
+	}
+	return appendASCII(dst, n, alphaNum)
+}
+
+// printableASCII contains the 95 printable ASCII characters from 0x20 through 0x7E.
+const printableASCII = " " + PasswordSymbols + alphaNum
+
+// ASCII returns a pseudo-random string of n characters drawn uniformly
+// from the printable ASCII characters, including space (0x20 through 0x7E).
+// It panics if n < 0.
+func ASCII(n int) string {
+	if n < 0 {
+		panic("fastrand.ASCII: invalid argument")
+	}
+	return bytesToString(appendASCII(make([]byte, 0, n), n, printableASCII))
+}
+
+// AppendASCII appends n pseudo-random printable ASCII characters
+// to dst and returns the extended buffer. See ASCII for details.
+// It panics if n < 0.
+func AppendASCII(dst []byte, n int) []byte {
+	if n < 0 {
+		panic("fastrand.AppendASCII: invalid argument")
+	}
+	return appendASCII(dst, n, printableASCII)
+}
+
+const hexDigits = "0123456789abcdef"
+
+// Hex returns a pseudo-random string of n lowercase hexadecimal characters.
+// It panics if n < 0.
+func Hex(n int) string {
+	if n < 0 {
+		panic("fastrand.Hex: invalid argument")
+	}
+	return bytesToString(appendHex(make([]byte, 0, n), n))
+}
+
+// AppendHex appends n pseudo-random lowercase hexadecimal characters
+// to dst and returns the extended buffer.
+// It panics if n < 0.
+func AppendHex(dst []byte, n int) []byte {
+	if n < 0 {
+		panic("fastrand.AppendHex: invalid argument")
+	}
+	return appendHex(dst, n)
+}
+
+// appendHex appends n hexadecimal characters to dst.
+func appendHex(dst []byte, n int) []byte {
+	for n > 0 {
+		v := u64()
+		for i := min(n, 16); i > 0; i-- {
+			dst = append(dst, hexDigits[v&0xf])
+			v >>= 4
+			n--
+		}
+	}
+	return dst
+}
+
+const (
+	base62Alphabet = alphaNum
+	base64Alphabet = alphaNum + "-_"
+
+	stdBase64Alphabet = alphaNum + "+/"
+)
+
+// Token returns the unpadded URL-safe base64 encoding (RFC 4648 §5)
+// of nBytes pseudo-random bytes.
+// It panics if nBytes < 0.
+func Token(nBytes int) string {
+	if nBytes < 0 {
+		panic("fastrand.Token: invalid argument")
+	}
+	// Each character holds six bits. If the bytes don't divide evenly,
+	// the unused low bits of the final character are zero, as in
+	// base64.RawURLEncoding.
+	bitLen := nBytes * 8
+	n := (bitLen + 5) / 6
+	b := make([]byte, n)
+	var v uint64
+	for i := range b {
+		if i%10 == 0 {
+			v = u64()
+		}
+		b[i] = byte(v & 0x3f)
+		v >>= 6
+	}
+	if extra := n*6 - bitLen; extra > 0 {
+		b[n-1] &^= 1<<extra - 1
+	}
+	for i, c := range b {
+		b[i] = base64Alphabet[c]
+	}
+	return bytesToString(b)
+}
+
+// TokenBase62 returns a pseudo-random string of characters drawn uniformly from
+// [A-Za-z0-9] holding at least as much entropy as nBytes pseudo-random bytes.
+// It panics if nBytes < 0.
+func TokenBase62(nBytes int) string {
+	if nBytes < 0 {
+		panic("fastrand.TokenBase62: invalid argument")
+	}
+	n := int(math.Ceil(float64(nBytes) * 8 / math.Log2(62)))
+	return bytesToString(appendASCII(make([]byte, 0, n), n, base62Alphabet))
+}
+
+// appendString appends n characters drawn uniformly from alphabet to dst.
+func appendString(dst []byte, n int, alphabet string) []byte {
+	if isASCII(alphabet) {
+		return appendASCII(dst, n, alphabet)
+	}
+	runes := []rune(alphabet)
+	x := newIndexer(len(runes))
+	for i := 0; i < n; i++ {
+		dst = utf8.AppendRune(dst, runes[x.next()])
+	}
+	return dst
+}
+
+// appendASCII appends n bytes drawn uniformly from the ASCII alphabet to dst.
+func appendASCII(dst []byte, n int, alphabet string) []byte {
+	x := newIndexer(len(alphabet))
+	for i := 0; i < n; i++ {
+		dst = append(dst, alphabet[x.next()])
+	}
+	return dst
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// An indexer draws uniform indices in the half-open interval [0,n)
+// without modulo bias. It splits each 64-bit draw into as many indices
+// as it can hold and rejects those that are out of range.
+type indexer struct {
+	n    uint64
+	bits int
+	mask uint64
+	buf  uint64
+	left int // number of unused bits in buf
+}
+
+func newIndexer(n int) indexer {
+	b := bits.Len64(uint64(n - 1))
+	return indexer{
+		n:    uint64(n),
+		bits: b,
+		mask: 1<<b - 1,
+	}
+}
+
+func (x *indexer) next() int {
+	for {
+		if x.left < x.bits {
+			x.buf = u64()
+			x.left = 64
+		}
+		v := x.buf & x.mask
+		x.buf >>= x.bits
+		x.left -= x.bits
+		if v < x.n {
+			return int(v)
+		}
+	}
+}
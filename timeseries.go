@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"math"
+	"time"
+)
+
+// A NoiseKind is the distribution of noise in a TimeSeries.
+type NoiseKind int
+
+const (
+	// NormalNoise adds normally distributed noise with mean zero
+	// and standard deviation NoiseScale to each value.
+	NormalNoise NoiseKind = iota
+	// LogNormalNoise multiplies each value by log-normally distributed noise
+	// whose logarithm has mean zero and standard deviation NoiseScale,
+	// as is typical of latencies and other positive quantities.
+	LogNormalNoise
+)
+
+// A Season is a sinusoidal seasonal component of a TimeSeries.
+type Season struct {
+	// Period is the duration of one cycle, e.g. 24 * time.Hour.
+	Period time.Duration
+	// Amplitude is the peak deviation from the trend.
+	Amplitude float64
+	// Phase delays the start of the cycle, which otherwise begins
+	// at Start with a value of zero and rises to its peak.
+	Phase time.Duration
+}
+
+// A TimeSeries describes a synthetic time series composed of a linear trend,
+// seasonal components, and noise.
+type TimeSeries struct {
+	// Start is the time of the first sample.
+	Start time.Time
+	// Step is the interval between samples.
+	Step time.Duration
+	// Base is the value of the trend at Start.
+	Base float64
+	// Trend is the change in the value of the trend per Step.
+	Trend float64
+	// Seasons are summed with the trend.
+	Seasons []Season
+	// Noise is the distribution of noise.
+	Noise NoiseKind
+	// NoiseScale is the standard deviation of noise.
+	NoiseScale float64
+}
+
+// A TimePoint is a timestamped value.
+type TimePoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// Samples returns n consecutive pseudo-random samples of the time series.
+// It panics if n < 0, if the Step or any Period is not positive,
+// or if the Noise is invalid.
+func (ts *TimeSeries) Samples(n int) []TimePoint {
+	if n < 0 || ts.Step <= 0 || !(ts.NoiseScale >= 0) {
+		panic("fastrand.TimeSeries.Samples: invalid argument")
+	}
+	for _, season := range ts.Seasons {
+		if season.Period <= 0 {
+			panic("fastrand.TimeSeries.Samples: invalid period")
+		}
+	}
+	s := make([]TimePoint, n)
+	for i := range s {
+		d := time.Duration(i) * ts.Step
+		v := ts.Base + ts.Trend*float64(i)
+		for _, season := range ts.Seasons {
+			v += season.Amplitude * math.Sin(2*math.Pi*float64(d-season.Phase)/float64(season.Period))
+		}
+		switch ts.Noise {
+		case NormalNoise:
+			v += NormFloat64() * ts.NoiseScale
+		case LogNormalNoise:
+			v *= math.Exp(NormFloat64() * ts.NoiseScale)
+		default:
+			panic("fastrand.TimeSeries.Samples: invalid noise")
+		}
+		s[i] = TimePoint{Time: ts.Start.Add(d), Value: v}
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "errors"
+
+// ErrInvalidBound is returned by the Try functions when the bound isn't positive.
+var ErrInvalidBound = errors.New("fastrand: invalid bound")
+
+// TryInt31n is like Int31n, but it returns ErrInvalidBound
+// instead of panicking if n <= 0.
+func TryInt31n(n int32) (int32, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return Int31n(n), nil
+}
+
+// TryInt63n is like Int63n, but it returns ErrInvalidBound
+// instead of panicking if n <= 0.
+func TryInt63n(n int64) (int64, error) {
+	if n <= 0 {
+		return 0, ErrInvalidBound
+	}
+	return Int63n(n), nil
+}
+
+// TryUint32n is like Uint32n, but it returns ErrInvalidBound if n == 0.
+func TryUint32n(n uint32) (uint32, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+	return Uint32n(n), nil
+}
+
+// TryUint64n is like Uint64n, but it returns ErrInvalidBound if n == 0.
+func TryUint64n(n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, ErrInvalidBound
+	}
+	return Uint64n(n), nil
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"sort"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Unicode returns a pseudo-random valid UTF-8 string of nRunes runes drawn
+// uniformly from the given range tables. If no tables are given, runes are
+// drawn from unicode.L, the letters of all scripts. Surrogate code points
+// are never drawn. Runes in more than one of the tables are proportionally
+// more likely to be drawn.
+// It panics if nRunes < 0 or if the tables contain no valid runes.
+func Unicode(nRunes int, tables ...*unicode.RangeTable) string {
+	if nRunes < 0 {
+		panic("fastrand.Unicode: invalid argument")
+	}
+	var rs runeSet
+	if len(tables) == 0 {
+		rs = letters()
+	} else {
+		rs = newRuneSet(tables...)
+	}
+	if rs.total == 0 {
+		panic("fastrand.Unicode: no valid runes")
+	}
+	b := make([]byte, 0, nRunes)
+	for i := 0; i < nRunes; i++ {
+		b = utf8.AppendRune(b, rs.pick())
+	}
+	return bytesToString(b)
+}
+
+var letters = sync.OnceValue(func() runeSet {
+	return newRuneSet(unicode.L)
+})
+
+// A runeSet is a flattened set of rune ranges from which runes can be picked uniformly.
+type runeSet struct {
+	ranges []runeRange
+	cum    []int // cumulative counts of runes in ranges
+	total  int
+}
+
+type runeRange struct {
+	lo, stride rune
+}
+
+func newRuneSet(tables ...*unicode.RangeTable) runeSet {
+	var rs runeSet
+	add := func(lo, hi, stride rune) {
+		if lo > hi {
+			return
+		}
+		rs.ranges = append(rs.ranges, runeRange{lo, stride})
+		rs.total += int((hi-lo)/stride) + 1
+		rs.cum = append(rs.cum, rs.total)
+	}
+	split := func(lo, hi, stride rune) {
+		hi = min(hi, utf8.MaxRune)
+		if hi < surrogateMin || lo > surrogateMax {
+			add(lo, hi, stride)
+			return
+		}
+		// Exclude surrogates, keeping each half aligned to the stride.
+		if lo < surrogateMin {
+			add(lo, lo+(surrogateMin-1-lo)/stride*stride, stride)
+		}
+		if next := lo + (surrogateMax+1-lo+stride-1)/stride*stride; next <= hi {
+			add(next, hi, stride)
+		}
+	}
+	for _, t := range tables {
+		for _, r := range t.R16 {
+			split(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+		for _, r := range t.R32 {
+			split(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+	return rs
+}
+
+const (
+	surrogateMin = 0xD800
+	surrogateMax = 0xDFFF
+)
+
+func (rs *runeSet) pick() rune {
+	x := intn(rs.total)
+	i := sort.SearchInts(rs.cum, x+1)
+	if i > 0 {
+		x -= rs.cum[i-1]
+	}
+	r := rs.ranges[i]
+	return r.lo + rune(x)*r.stride
+}
+
+// edgeRunes are categories of code points that are valid in UTF-8
+// but are known to trip up text processing.
+var edgeRunes = [][][2]rune{
+	// ASCII letters, which give combining marks something to combine with.
+	{{'A', 'Z'}, {'a', 'z'}},
+	// Combining marks.
+	{{0x0300, 0x036F}, {0x1AB0, 0x1AFF}, {0x20D0, 0x20FF}, {0xFE20, 0xFE2F}},
+	// Zero-width characters and the byte order mark.
+	{{0x200B, 0x200D}, {0x2060, 0x2060}, {0xFEFF, 0xFEFF}},
+	// Bidirectional formatting characters.
+	{{0x061C, 0x061C}, {0x200E, 0x200F}, {0x202A, 0x202E}, {0x2066, 0x2069}},
+	// Code points adjacent to the surrogates, the replacement character,
+	// and noncharacters.
+	{{0xD7FF, 0xD7FF}, {0xE000, 0xE000}, {0xFDD0, 0xFDEF}, {0xFFFD, 0xFFFF}, {0x1FFFE, 0x1FFFF}, {0x10FFFE, 0x10FFFF}},
+	// The boundaries between UTF-8 encoding lengths.
+	{{0x0000, 0x0000}, {0x007F, 0x0080}, {0x07FF, 0x0800}, {0xFFFF, 0xFFFF}, {0x10000, 0x10000}},
+	// Unusual whitespace and line terminators.
+	{{0x0085, 0x0085}, {0x00A0, 0x00A0}, {0x1680, 0x1680}, {0x2000, 0x200A}, {0x2028, 0x2029}, {0x3000, 0x3000}},
+	// Variation selectors, regional indicators, emoji, and skin tone modifiers.
+	{{0xFE00, 0xFE0F}, {0x1F1E6, 0x1F1FF}, {0x1F300, 0x1F5FF}, {0x1F3FB, 0x1F3FF}},
+	// Letters outside of the Basic Multilingual Plane.
+	{{0x10400, 0x1044F}, {0x1D400, 0x1D7FF}, {0x20000, 0x2A6DF}},
+}
+
+// UnicodeEdge returns a pseudo-random valid UTF-8 string of nRunes runes
+// biased toward code points that commonly break text processing:
+// combining marks, zero-width characters, bidirectional controls,
+// code points adjacent to the surrogates, noncharacters, the boundaries
+// between UTF-8 encoding lengths (including NUL), unusual whitespace,
+// and emoji modifiers.
+// It panics if nRunes < 0.
+func UnicodeEdge(nRunes int) string {
+	if nRunes < 0 {
+		panic("fastrand.UnicodeEdge: invalid argument")
+	}
+	b := make([]byte, 0, nRunes*3)
+	for i := 0; i < nRunes; i++ {
+		ranges := Pick(edgeRunes)
+		total := 0
+		for _, r := range ranges {
+			total += int(r[1]-r[0]) + 1
+		}
+		x := rune(intn(total))
+		for _, r := range ranges {
+			if n := r[1] - r[0] + 1; x >= n {
+				x -= n
+				continue
+			}
+			b = utf8.AppendRune(b, r[0]+x)
+			break
+		}
+	}
+	return bytesToString(b)
+}
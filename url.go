@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// A URLOption configures the generation of a pseudo-random URL.
+type URLOption func(*urlOptions)
+
+type urlOptions struct {
+	schemes      []string
+	hosts        []string
+	maxSegments  int
+	maxParams    int
+	userinfoProb float64
+	fragmentProb float64
+}
+
+// URLSchemes returns a URLOption that draws schemes from the given list.
+// The default schemes are "http" and "https".
+func URLSchemes(schemes ...string) URLOption {
+	return func(o *urlOptions) { o.schemes = schemes }
+}
+
+// URLHosts returns a URLOption that draws hosts, which may include ports,
+// from the given list. By default, hosts are pseudo-random hostnames
+// or IP addresses with occasional ports.
+func URLHosts(hosts ...string) URLOption {
+	return func(o *urlOptions) { o.hosts = hosts }
+}
+
+// URLMaxPathSegments returns a URLOption that sets the maximum number
+// of path segments. The default is 4.
+func URLMaxPathSegments(n int) URLOption {
+	return func(o *urlOptions) { o.maxSegments = n }
+}
+
+// URLMaxQueryParams returns a URLOption that sets the maximum number
+// of query parameters. The default is 3.
+func URLMaxQueryParams(n int) URLOption {
+	return func(o *urlOptions) { o.maxParams = n }
+}
+
+// URLUserinfoProbability returns a URLOption that sets the probability
+// that a URL includes userinfo. The default is 0.1.
+func URLUserinfoProbability(p float64) URLOption {
+	return func(o *urlOptions) { o.userinfoProb = p }
+}
+
+// URLFragmentProbability returns a URLOption that sets the probability
+// that a URL includes a fragment. The default is 0.1.
+func URLFragmentProbability(p float64) URLOption {
+	return func(o *urlOptions) { o.fragmentProb = p }
+}
+
+var defaultURLSchemes = []string{"http", "https"}
+
+const (
+	// urlChars are the characters used for path segments, query parameters,
+	// and fragments. Those that must be escaped are escaped by url.URL.
+	urlChars = alphaNum + "-._~!$&'()*+,;=:@ %/?#"
+	urlParts = 8 // maximum length of generated path segments and such
+)
+
+// URL returns a pseudo-random, syntactically valid absolute URL with a scheme,
+// a host, and a path, and optionally userinfo, a query, and a fragment.
+// Use its String method to get the encoded form.
+// It panics if any of the options are invalid.
+func URL(opts ...URLOption) *url.URL {
+	o := urlOptions{
+		schemes:      defaultURLSchemes,
+		maxSegments:  4,
+		maxParams:    3,
+		userinfoProb: 0.1,
+		fragmentProb: 0.1,
+	}
+	for _, fn := range opts {
+		fn(&o)
+	}
+	if len(o.schemes) == 0 || o.maxSegments < 0 || o.maxParams < 0 ||
+		!(o.userinfoProb >= 0 && o.userinfoProb <= 1) ||
+		!(o.fragmentProb >= 0 && o.fragmentProb <= 1) {
+		panic("fastrand.URL: invalid option")
+	}
+
+	u := &url.URL{Scheme: Pick(o.schemes)}
+	if len(o.hosts) > 0 {
+		u.Host = Pick(o.hosts)
+	} else {
+		u.Host = urlHost()
+	}
+	if Float64() < o.userinfoProb {
+		if Float64() < 0.5 {
+			u.User = url.User(AlphaNum(1 + intn(urlParts)))
+		} else {
+			u.User = url.UserPassword(AlphaNum(1+intn(urlParts)), String(1+intn(urlParts), urlChars))
+		}
+	}
+
+	var path strings.Builder
+	for i := intn(o.maxSegments + 1); i > 0; i-- {
+		path.WriteByte('/')
+		path.WriteString(String(1+intn(urlParts), urlChars))
+	}
+	u.Path = path.String()
+
+	if n := intn(o.maxParams + 1); n > 0 {
+		q := make(url.Values, n)
+		for i := 0; i < n; i++ {
+			q.Add(AlphaNum(1+intn(urlParts)), String(intn(urlParts+1), urlChars))
+		}
+		u.RawQuery = q.Encode()
+	}
+	if Float64() < o.fragmentProb {
+		u.Fragment = String(1+intn(urlParts), urlChars)
+	}
+	return u
+}
+
+func urlHost() string {
+	var host string
+	switch x := Float64(); {
+	case x < 0.6:
+		host = Hostname(1 + intn(3))
+	case x < 0.8:
+		host = IPv4InPrefix(netip.PrefixFrom(netip.IPv4Unspecified(), 0)).String()
+	default:
+		host = "[" + IPv6InPrefix(netip.PrefixFrom(netip.IPv6Unspecified(), 0)).String() + "]"
+	}
+	if Float64() < 0.2 {
+		host += ":" + strconv.Itoa(int(Port(1, 65535)))
+	}
+	return host
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "math"
+
+// Walk returns the first n positions of a pseudo-random walk that begins
+// at start and moves by a step drawn from the step function at each position.
+// It panics if n < 0.
+func Walk(n int, start float64, step func() float64) []float64 {
+	if n < 0 {
+		panic("fastrand.Walk: invalid argument")
+	}
+	s := make([]float64, n)
+	x := start
+	for i := range s {
+		s[i] = x
+		x += step()
+	}
+	return s
+}
+
+// SimpleWalk returns the first n positions of a simple pseudo-random walk
+// that begins at start and moves up or down by one with equal probability.
+// It panics if n < 0.
+func SimpleWalk(n int, start int64) []int64 {
+	if n < 0 {
+		panic("fastrand.SimpleWalk: invalid argument")
+	}
+	s := make([]int64, n)
+	x := start
+	var bits uint64
+	for i := range s {
+		s[i] = x
+		if i&63 == 0 {
+			bits = u64()
+		}
+		x += int64(bits&1)*2 - 1
+		bits >>= 1
+	}
+	return s
+}
+
+// GaussianWalk returns the first n positions of a pseudo-random walk that
+// begins at start and moves by normally distributed steps with mean zero
+// and standard deviation stddev.
+// It panics if n < 0 or stddev is negative.
+func GaussianWalk(n int, start, stddev float64) []float64 {
+	if n < 0 || !(stddev >= 0) {
+		panic("fastrand.GaussianWalk: invalid argument")
+	}
+	return Walk(n, start, func() float64 { return NormFloat64() * stddev })
+}
+
+// GeometricBrownianMotion returns n pseudo-random samples of a geometric
+// Brownian motion path, as is commonly used to model prices, beginning at
+// start and sampled at intervals of dt with drift mu and volatility sigma
+// per unit of time. The samples are exact: they have no discretization error.
+// It panics if n < 0, start or dt is not positive, or sigma is negative.
+func GeometricBrownianMotion(n int, start, mu, sigma, dt float64) []float64 {
+	if n < 0 || !(start > 0) || !(dt > 0) || !(sigma >= 0) {
+		panic("fastrand.GeometricBrownianMotion: invalid argument")
+	}
+	drift := (mu - sigma*sigma/2) * dt
+	vol := sigma * math.Sqrt(dt)
+	s := make([]float64, n)
+	x := start
+	for i := range s {
+		s[i] = x
+		x *= math.Exp(drift + vol*NormFloat64())
+	}
+	return s
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	"math"
+	"sort"
+)
+
+// NormalizeWeights returns a new slice of probabilities proportional to weights
+// that sums to one.
+// It panics if any weight is negative, infinite, or NaN,
+// or if the weights don't have a positive finite sum.
+func NormalizeWeights(weights []float64) []float64 {
+	total := sumWeights(weights, "fastrand.NormalizeWeights")
+	p := make([]float64, len(weights))
+	for i, w := range weights {
+		p[i] = w / total
+	}
+	return p
+}
+
+// CumulativeWeights returns a new slice in which each element is the sum of
+// the weights up to and including the corresponding index. The result is
+// suitable for use with PickCumulative.
+// It panics if any weight is negative, infinite, or NaN,
+// or if the weights don't have a positive finite sum.
+func CumulativeWeights(weights []float64) []float64 {
+	sumWeights(weights, "fastrand.CumulativeWeights")
+	c := make([]float64, len(weights))
+	var sum float64
+	for i, w := range weights {
+		sum += w
+		c[i] = sum
+	}
+	return c
+}
+
+// PickWeighted returns a pseudo-random index of weights chosen with probability
+// proportional to its weight. Indices with zero weight are never chosen.
+// To repeatedly pick from the same weights, use CumulativeWeights and PickCumulative.
+// It panics if any weight is negative, infinite, or NaN,
+// or if the weights don't have a positive finite sum.
+func PickWeighted(weights []float64) int {
+	total := sumWeights(weights, "fastrand.PickWeighted")
+	for {
+		x := Float64() * total
+		for i, w := range weights {
+			if x < w {
+				return i
+			}
+			x -= w
+		}
+		// Rounding error pushed x past the last positive weight. Try again.
+	}
+}
+
+// PickCumulative returns a pseudo-random index of the cumulative weights
+// returned by CumulativeWeights, chosen with probability proportional to
+// the weight it was built from. Indices with zero weight are never chosen.
+// It panics if cum is empty or its total is not positive and finite.
+func PickCumulative(cum []float64) int {
+	n := len(cum)
+	if n == 0 {
+		panic("fastrand.PickCumulative: empty weights")
+	}
+	total := cum[n-1]
+	if !(total > 0 && total <= math.MaxFloat64) {
+		panic("fastrand.PickCumulative: invalid weights")
+	}
+	for {
+		x := Float64() * total
+		if i := sort.SearchFloat64s(cum, math.Nextafter(x, math.Inf(1))); i < n {
+			return i
+		}
+		// Rounding pushed x to the total. Try again.
+	}
+}
+
+// sumWeights returns the sum of weights or panics with a message prefixed by name.
+func sumWeights(weights []float64, name string) float64 {
+	var total float64
+	for _, w := range weights {
+		if !(w >= 0 && w <= math.MaxFloat64) {
+			panic(name + ": invalid weight")
+		}
+		total += w
+	}
+	if !(total > 0 && total <= math.MaxFloat64) {
+		panic(name + ": invalid weights")
+	}
+	return total
+}
+
+// ShuffleWeighted pseudo-randomizes the order of items such that an item's
+// likelihood of preceding others is proportional to its weight.
+// The first item is chosen with probability proportional to its weight,
+// the second is chosen likewise from the remainder, and so on.
+// Items with zero weight are placed last in uniformly random order.
+//
+// The weights are reordered along with the items, so weights[i] continues
+// to be the weight of items[i].
+// It panics if the lengths of items and weights differ
+// or if any weight is negative or NaN.
+func ShuffleWeighted[E any](items []E, weights []float64) {
+	if len(items) != len(weights) {
+		panic("fastrand.ShuffleWeighted: mismatched lengths")
+	}
+	// Each item is given an exponentially distributed key with rate equal to its weight
+	// and the items are sorted by ascending key. The minimum of independent exponential
+	// variables is attained by each with probability proportional to its rate.
+	keys := make([]float64, len(items))
+	zeros := 0
+	for i, w := range weights {
+		if !(w >= 0) {
+			panic("fastrand.ShuffleWeighted: invalid weight")
+		}
+		if w == 0 {
+			keys[i] = math.Inf(1)
+			zeros++
+			continue
+		}
+		// A tiny weight may overflow the key, which must remain
+		// finite to keep the item ahead of those with zero weight.
+		keys[i] = min(-math.Log(unitOpenZero())/w, math.MaxFloat64)
+	}
+	sort.Sort(&weightedShuffle[E]{keys, items, weights})
+	k := len(items) - zeros
+	ShuffleInterface(&weightedShuffle[E]{keys[k:], items[k:], weights[k:]})
+}
+
+type weightedShuffle[E any] struct {
+	keys    []float64
+	items   []E
+	weights []float64
+}
+
+func (s *weightedShuffle[E]) Len() int           { return len(s.keys) }
+func (s *weightedShuffle[E]) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+
+func (s *weightedShuffle[E]) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+	s.weights[i], s.weights[j] = s.weights[j], s.weights[i]
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import "testing"
+
+func TestShuffleWeightedTinyWeight(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		items := []int{0, 1, 2, 3, 4}
+		weights := []float64{0, 5e-324, 0, 1, 0}
+		ShuffleWeighted(items, weights)
+		for j, item := range items {
+			if want := []float64{0, 5e-324, 0, 1, 0}[item]; weights[j] != want {
+				t.Fatalf("ShuffleWeighted: item %d has weight %v; want %v", item, weights[j], want)
+			}
+		}
+		if items[0] != 3 || items[1] != 1 {
+			t.Fatalf("ShuffleWeighted: items = %v; want [3 1 ...]", items)
+		}
+	}
+}
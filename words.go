@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright 2023 Andrew Bursavich. All rights reserved.
+// Use of this source code is governed by The MIT License
+// which can be found in the LICENSE file.
+
+package fastrand
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed words.txt
+var wordsTxt string
+
+var defaultWords = sync.OnceValue(func() []string {
+	return strings.Fields(wordsTxt)
+})
+
+// Words returns n pseudo-random words drawn uniformly from list, joined by sep.
+// If list is empty, an embedded list of several hundred short, common
+// English words is used, producing results like "brave-otter-lamp".
+// It panics if n < 0.
+func Words(n int, list []string, sep string) string {
+	if n < 0 {
+		panic("fastrand.Words: invalid argument")
+	}
+	if len(list) == 0 {
+		list = defaultWords()
+	}
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(list[intn(len(list))])
+	}
+	return b.String()
+}
+
+// Slug returns a lowercase, hyphen-separated slug of n pseudo-random words
+// from the embedded default list, such as "brave-otter-lamp".
+// It panics if n < 1.
+func Slug(n int) string {
+	if n < 1 {
+		panic("fastrand.Slug: invalid argument")
+	}
+	return Words(n, nil, "-")
+}